@@ -0,0 +1,75 @@
+terraform {
+  required_providers {
+    nifcloud = {
+      source = "nifcloud/nifcloud"
+    }
+  }
+}
+
+provider "nifcloud" {
+  region = "jp-east-1"
+}
+
+resource "nifcloud_auto_scaling_group" "web" {
+  name               = "webgroup"
+  image_id           = nifcloud_image.web.image_id
+  instance_type      = "small"
+  security_group     = [nifcloud_security_group.web.group_name]
+  min_size           = 1
+  max_size           = 3
+  change_in_capacity = 1
+  scaleout_condition = "or"
+  description        = "memo"
+
+  trigger {
+    resource        = "Server-cpu"
+    upper_threshold = 80
+    breach_duration = 5
+  }
+
+  schedule {
+    days               = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+    starting_time_zone = "9"
+    ending_time_zone   = "18"
+  }
+}
+
+resource "nifcloud_image" "web" {
+  instance_id       = nifcloud_instance.web.instance_id
+  image_name        = "webimage"
+  description       = "memo"
+  left_instance     = true
+  availability_zone = "east-12"
+}
+
+resource "nifcloud_instance" "web" {
+  instance_id       = "web001"
+  availability_zone = "east-12"
+  image_id          = data.nifcloud_image.ubuntu.id
+  key_name          = nifcloud_key_pair.web.key_name
+  security_group    = nifcloud_security_group.web.group_name
+  instance_type     = "small"
+  accounting_type   = "2"
+
+  network_interface {
+    network_id = "net-COMMON_GLOBAL"
+  }
+
+  network_interface {
+    network_id = "net-COMMON_PRIVATE"
+  }
+}
+
+resource "nifcloud_key_pair" "web" {
+  key_name   = "webkey"
+  public_key = "c3NoLXJzYSBBQUFBQjNOemFDMXljMkVBQUFBREFRQUJBQUFCQVFEeFVVSmtIWFFvalVmeGphT3dQNVJmMjhOTVRFSjJFblBQdFk0b1NkZFBpRllnMWVDTGFNU08wV25nZVIrVk5sU215am1qU2xRWjBsc1BkcHZjWnY0KzZiMDlLUUZlT3NxakdjNE9Ga1o2MTZyTEI3UmdzblZnSXl3QmtIZ2lsMVQzbFRwRHVtYVk2TFFaRjRiaVpTNkNyaFdYeVhiSjFUVmYyZ0hIYXZPdi9WSS9ITjhIejlnSDg5Q0xWRVFOWFVQbXdjbC83ZE4yMXE4QnhNVkpGNW1sSW1RcGxwTjFKQVRwdnBXSXVXSzZZOFpYblEvYVowMDBMTFVBMVA4N1l3V2FRSWJRTGVPelNhc29GYm5pbkZ3R05FdVdCK0t5MWNMQkRZc1lmZExHQnVYTkRlVmtnUUE3ODJXWWxaNU1lN0RVMWt0Q0U3Qk5jOUlyUVA1YWZDU2g="
+}
+
+resource "nifcloud_security_group" "web" {
+  group_name        = "webfw"
+  availability_zone = "east-12"
+}
+
+data "nifcloud_image" "ubuntu" {
+  image_name = "Ubuntu Server 22.04 LTS"
+}
@@ -0,0 +1,80 @@
+terraform {
+  required_providers {
+    nifcloud = {
+      source = "nifcloud/nifcloud"
+    }
+  }
+}
+
+provider "nifcloud" {
+  region = "jp-east-1"
+}
+
+resource "nifcloud_elb_instance_attachment" "web" {
+  elb_id        = nifcloud_elb.web.elb_id
+  protocol      = nifcloud_elb.web.protocol
+  lb_port       = nifcloud_elb.web.lb_port
+  instance_port = nifcloud_elb.web.instance_port
+  instance_id   = nifcloud_instance.web.instance_id
+}
+
+resource "nifcloud_elb" "web" {
+  elb_name          = "webelb"
+  availability_zone = "east-11"
+  instance_port     = 80
+  protocol          = "HTTP"
+  lb_port           = 80
+
+  network_interface {
+    network_id     = "net-COMMON_GLOBAL"
+    is_vip_network = true
+  }
+
+  network_interface {
+    network_id     = nifcloud_private_lan.web.network_id
+    ip_address     = "192.168.100.1"
+    is_vip_network = false
+  }
+
+  lifecycle {
+    ignore_changes = [instances]
+  }
+}
+
+resource "nifcloud_private_lan" "web" {
+  private_lan_name  = "weblan"
+  availability_zone = "east-11"
+  cidr_block        = "192.168.100.0/24"
+}
+
+resource "nifcloud_instance" "web" {
+  instance_id       = "web001"
+  availability_zone = "east-11"
+  image_id          = data.nifcloud_image.ubuntu.id
+  key_name          = nifcloud_key_pair.web.key_name
+  security_group    = nifcloud_security_group.web.group_name
+  instance_type     = "small"
+  accounting_type   = "2"
+
+  network_interface {
+    network_id = "net-COMMON_GLOBAL"
+  }
+
+  network_interface {
+    network_id = nifcloud_private_lan.web.network_id
+  }
+}
+
+resource "nifcloud_key_pair" "web" {
+  key_name   = "webkey"
+  public_key = "c3NoLXJzYSBBQUFBQjNOemFDMXljMkVBQUFBREFRQUJBQUFCQVFEeFVVSmtIWFFvalVmeGphT3dQNVJmMjhOTVRFSjJFblBQdFk0b1NkZFBpRllnMWVDTGFNU08wV25nZVIrVk5sU215am1qU2xRWjBsc1BkcHZjWnY0KzZiMDlLUUZlT3NxakdjNE9Ga1o2MTZyTEI3UmdzblZnSXl3QmtIZ2lsMVQzbFRwRHVtYVk2TFFaRjRiaVpTNkNyaFdYeVhiSjFUVmYyZ0hIYXZPdi9WSS9ITjhIejlnSDg5Q0xWRVFOWFVQbXdjbC83ZE4yMXE4QnhNVkpGNW1sSW1RcGxwTjFKQVRwdnBXSXVXSzZZOFpYblEvYVowMDBMTFVBMVA4N1l3V2FRSWJRTGVPelNhc29GYm5pbkZ3R05FdVdCK0t5MWNMQkRZc1lmZExHQnVYTkRlVmtnUUE3ODJXWWxaNU1lN0RVMWt0Q0U3Qk5jOUlyUVA1YWZDU2g="
+}
+
+resource "nifcloud_security_group" "web" {
+  group_name        = "webfw"
+  availability_zone = "east-11"
+}
+
+data "nifcloud_image" "ubuntu" {
+  image_name = "Ubuntu Server 22.04 LTS"
+}
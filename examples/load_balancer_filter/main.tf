@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    nifcloud = {
+      source = "nifcloud/nifcloud"
+    }
+  }
+}
+
+provider "nifcloud" {
+  region = "jp-east-1"
+}
+
+resource "nifcloud_load_balancer_filter" "office" {
+  load_balancer_name = nifcloud_load_balancer.web.load_balancer_name
+  load_balancer_port = nifcloud_load_balancer.web.load_balancer_port
+  instance_port      = nifcloud_load_balancer.web.instance_port
+  ip_address         = "192.0.2.1"
+}
+
+resource "nifcloud_load_balancer" "web" {
+  load_balancer_name = "l4lb"
+  instance_port      = 80
+  load_balancer_port = 80
+  accounting_type    = "1"
+  filter_type        = "1"
+
+  lifecycle {
+    ignore_changes = [filter]
+  }
+}
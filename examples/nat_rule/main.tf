@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    nifcloud = {
+      source = "nifcloud/nifcloud"
+    }
+  }
+}
+
+provider "nifcloud" {
+  region = "jp-east-1"
+}
+
+resource "nifcloud_nat_rule" "snat" {
+  nat_table_id                  = nifcloud_nat_table.shared.nat_table_id
+  nat_type                      = "snat"
+  rule_number                   = "1"
+  description                   = "memo"
+  protocol                      = "ALL"
+  source_address                = "192.168.1.10"
+  outbound_interface_network_id = "net-COMMON_GLOBAL"
+}
+
+resource "nifcloud_nat_rule" "dnat" {
+  nat_table_id                 = nifcloud_nat_table.shared.nat_table_id
+  nat_type                     = "dnat"
+  rule_number                  = "1"
+  description                  = "memo"
+  protocol                     = "TCP"
+  destination_port             = 8080
+  translation_address          = "192.168.1.10"
+  translation_port             = 80
+  inbound_interface_network_id = "net-COMMON_GLOBAL"
+}
+
+resource "nifcloud_nat_table" "shared" {
+  lifecycle {
+    ignore_changes = [snat, dnat]
+  }
+}
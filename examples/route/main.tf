@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    nifcloud = {
+      source = "nifcloud/nifcloud"
+    }
+  }
+}
+
+provider "nifcloud" {
+  region = "jp-east-1"
+}
+
+resource "nifcloud_route" "to_office" {
+  route_table_id = nifcloud_route_table.shared.route_table_id
+  cidr_block     = "10.0.1.0/24"
+  ip_address     = "192.168.1.254"
+}
+
+resource "nifcloud_route" "to_internet" {
+  route_table_id = nifcloud_route_table.shared.route_table_id
+  cidr_block     = "10.0.2.0/24"
+  network_id     = "net-COMMON_GLOBAL"
+}
+
+resource "nifcloud_route_table" "shared" {
+  lifecycle {
+    ignore_changes = [route]
+  }
+}
@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    nifcloud = {
+      source = "nifcloud/nifcloud"
+    }
+  }
+}
+
+provider "nifcloud" {
+  region = "jp-east-1"
+}
+
+resource "nifcloud_route_table_association" "example" {
+  route_table_id = nifcloud_route_table.example.route_table_id
+  router_id      = nifcloud_router.example.router_id
+}
+
+resource "nifcloud_route_table" "example" {
+  route {
+    cidr_block = "10.0.1.0/24"
+    ip_address = "192.168.1.254"
+  }
+}
+
+resource "nifcloud_router" "example" {
+  name              = "example"
+  availability_zone = "east-11"
+  accounting_type   = "2"
+  type              = "small"
+  security_group    = nifcloud_security_group.example.group_name
+
+  network_interface {
+    network_id = nifcloud_private_lan.example.id
+    ip_address = "192.168.1.1"
+  }
+
+  lifecycle {
+    ignore_changes = [route_table_id]
+  }
+}
+
+resource "nifcloud_private_lan" "example" {
+  private_lan_name  = "example"
+  availability_zone = "east-11"
+  cidr_block        = "192.168.1.0/24"
+}
+
+resource "nifcloud_security_group" "example" {
+  group_name        = "example"
+  availability_zone = "east-11"
+}
@@ -0,0 +1,60 @@
+terraform {
+  required_providers {
+    nifcloud = {
+      source = "nifcloud/nifcloud"
+    }
+  }
+}
+
+provider "nifcloud" {
+  region = "jp-east-1"
+}
+
+resource "nifcloud_router_network_interface" "example" {
+  router_id      = nifcloud_router.example.router_id
+  network_id     = nifcloud_private_lan.additional.id
+  ip_address     = "192.168.2.1"
+  dhcp           = true
+  dhcp_config_id = nifcloud_dhcp_config.example.id
+}
+
+resource "nifcloud_router" "example" {
+  name              = "example"
+  availability_zone = "east-11"
+  accounting_type   = "2"
+  type              = "small"
+  security_group    = nifcloud_security_group.example.group_name
+
+  network_interface {
+    network_id = nifcloud_private_lan.example.id
+    ip_address = "192.168.1.1"
+  }
+
+  lifecycle {
+    ignore_changes = [network_interface]
+  }
+}
+
+resource "nifcloud_dhcp_config" "example" {
+  ipaddress_pool {
+    ipaddress_pool_start = "192.168.2.50"
+    ipaddress_pool_stop  = "192.168.2.100"
+  }
+}
+
+resource "nifcloud_private_lan" "example" {
+  private_lan_name  = "example"
+  availability_zone = "east-11"
+  cidr_block        = "192.168.1.0/24"
+}
+
+resource "nifcloud_private_lan" "additional" {
+  private_lan_name  = "additional"
+  availability_zone = "east-11"
+  cidr_block        = "192.168.2.0/24"
+}
+
+resource "nifcloud_security_group" "example" {
+  group_name        = "example"
+  availability_zone = "east-11"
+}
@@ -0,0 +1,238 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"golang.org/x/sync/errgroup"
+)
+
+func init() {
+	resource.AddTestSweepers("nifcloud_alarm", &resource.Sweeper{
+		Name: "nifcloud_alarm",
+		F:    testSweepAlarm,
+	})
+}
+
+func TestAcc_Alarm(t *testing.T) {
+	var alarm types.ReservationSetOfNiftyDescribeAlarms
+
+	resourceName := "nifcloud_alarm.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccAlarmResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarm(t, "testdata/alarm.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmExists(resourceName, &alarm),
+					testAccCheckAlarmValues(&alarm, randName),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", randName),
+					resource.TestCheckResourceAttr(resourceName, "function_name", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_condition", "or"),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(resourceName, "email_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.data_type", "CPU"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.upper_lower_condition", "upper"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.breach_duration", "5"),
+				),
+			},
+			{
+				Config: testAccAlarm(t, "testdata/alarm_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmExists(resourceName, &alarm),
+					testAccCheckAlarmValuesUpdated(&alarm, randName),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", randName+"upd"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_condition", "and"),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo-upd"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.threshold", "90"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAlarm(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckAlarmExists(n string, alarm *types.ReservationSetOfNiftyDescribeAlarms) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no alarm resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no alarm id is set")
+		}
+
+		svc := testAccProvider.Meta().(*client.Client).Computing
+		res, err := svc.NiftyDescribeAlarms(context.Background(), &computing.NiftyDescribeAlarmsInput{
+			Rule: []types.RequestRuleOfNiftyDescribeAlarms{
+				{
+					FunctionName: types.FunctionNameOfRuleForNiftyDescribeAlarms(saved.Primary.Attributes["function_name"]),
+					RuleName:     nifcloud.String(saved.Primary.ID),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if res == nil || len(res.ReservationSet) == 0 {
+			return fmt.Errorf("alarm does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		foundAlarm := res.ReservationSet[0]
+
+		if nifcloud.ToString(foundAlarm.RuleName) != saved.Primary.ID {
+			return fmt.Errorf("alarm does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		*alarm = foundAlarm
+		return nil
+	}
+}
+
+func testAccCheckAlarmValues(alarm *types.ReservationSetOfNiftyDescribeAlarms, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(alarm.RuleName) != rName {
+			return fmt.Errorf("bad rule_name state, expected \"%s\", got: %#v", rName, alarm.RuleName)
+		}
+
+		if nifcloud.ToString(alarm.FunctionName) != "Server" {
+			return fmt.Errorf("bad function_name state, expected \"Server\", got: %#v", alarm.FunctionName)
+		}
+
+		if nifcloud.ToString(alarm.AlarmCondition) != "or" {
+			return fmt.Errorf("bad alarm_condition state, expected \"or\", got: %#v", alarm.AlarmCondition)
+		}
+
+		if len(alarm.RuleSet) != 1 || nifcloud.ToFloat64(alarm.RuleSet[0].Threshold) != 80 {
+			return fmt.Errorf("bad rule state, expected threshold 80, got: %#v", alarm.RuleSet)
+		}
+
+		if nifcloud.ToString(alarm.Description) != "memo" {
+			return fmt.Errorf("bad description state, expected \"memo\", got: %#v", alarm.Description)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAlarmValuesUpdated(alarm *types.ReservationSetOfNiftyDescribeAlarms, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(alarm.RuleName) != rName+"upd" {
+			return fmt.Errorf("bad rule_name state, expected \"%s\", got: %#v", rName+"upd", alarm.RuleName)
+		}
+
+		if nifcloud.ToString(alarm.AlarmCondition) != "and" {
+			return fmt.Errorf("bad alarm_condition state, expected \"and\", got: %#v", alarm.AlarmCondition)
+		}
+
+		if len(alarm.RuleSet) != 1 || nifcloud.ToFloat64(alarm.RuleSet[0].Threshold) != 90 {
+			return fmt.Errorf("bad rule state, expected threshold 90, got: %#v", alarm.RuleSet)
+		}
+
+		if nifcloud.ToString(alarm.Description) != "memo-upd" {
+			return fmt.Errorf("bad description state, expected \"memo-upd\", got: %#v", alarm.Description)
+		}
+		return nil
+	}
+}
+
+func testAccAlarmResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_alarm" {
+			continue
+		}
+
+		res, err := svc.NiftyDescribeAlarms(context.Background(), &computing.NiftyDescribeAlarmsInput{
+			Rule: []types.RequestRuleOfNiftyDescribeAlarms{
+				{
+					FunctionName: types.FunctionNameOfRuleForNiftyDescribeAlarms(rs.Primary.Attributes["function_name"]),
+					RuleName:     nifcloud.String(rs.Primary.ID),
+				},
+			},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.RuleName" {
+				return nil
+			}
+			return fmt.Errorf("failed NiftyDescribeAlarmsRequest: %s", err)
+		}
+
+		if len(res.ReservationSet) > 0 {
+			return fmt.Errorf("alarm (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testSweepAlarm(region string) error {
+	ctx := context.Background()
+	svc := sharedClientForRegion(region).Computing
+
+	res, err := svc.NiftyDescribeAlarms(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var sweepAlarms []types.ReservationSetOfNiftyDescribeAlarms
+	for _, a := range res.ReservationSet {
+		if strings.HasPrefix(nifcloud.ToString(a.RuleName), prefix) {
+			sweepAlarms = append(sweepAlarms, a)
+		}
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, a := range sweepAlarms {
+		alarm := a
+		eg.Go(func() error {
+			_, err := svc.NiftyDeleteAlarm(ctx, &computing.NiftyDeleteAlarmInput{
+				FunctionName: types.FunctionNameOfNiftyDeleteAlarmRequest(nifcloud.ToString(alarm.FunctionName)),
+				RuleName:     alarm.RuleName,
+			})
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return nil
+}
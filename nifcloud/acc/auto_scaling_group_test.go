@@ -0,0 +1,228 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"golang.org/x/sync/errgroup"
+)
+
+func init() {
+	resource.AddTestSweepers("nifcloud_auto_scaling_group", &resource.Sweeper{
+		Name: "nifcloud_auto_scaling_group",
+		F:    testSweepAutoScalingGroup,
+	})
+}
+
+func TestAcc_AutoScalingGroup(t *testing.T) {
+	var group types.AutoScalingReservationSet
+
+	resourceName := "nifcloud_auto_scaling_group.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccAutoScalingGroupResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoScalingGroup(t, "testdata/auto_scaling_group.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutoScalingGroupExists(resourceName, &group),
+					testAccCheckAutoScalingGroupValues(&group, randName),
+					resource.TestCheckResourceAttr(resourceName, "name", randName),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "mini"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "change_in_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaleout_condition", "or"),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.resource", "Server-cpu"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.upper_threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.breach_duration", "5"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.days.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.starting_time_zone", "9"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.ending_time_zone", "18"),
+				),
+			},
+			{
+				Config: testAccAutoScalingGroup(t, "testdata/auto_scaling_group_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutoScalingGroupExists(resourceName, &group),
+					testAccCheckAutoScalingGroupValuesUpdated(&group, randName),
+					resource.TestCheckResourceAttr(resourceName, "name", randName+"upd"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "3"),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo-upd"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.upper_threshold", "70"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutoScalingGroup(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckAutoScalingGroupExists(n string, group *types.AutoScalingReservationSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no auto scaling group resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no auto scaling group id is set")
+		}
+
+		svc := testAccProvider.Meta().(*client.Client).Computing
+		res, err := svc.NiftyDescribeAutoScalingGroups(context.Background(), &computing.NiftyDescribeAutoScalingGroupsInput{
+			AutoScalingGroupName: []string{saved.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+
+		if res == nil || len(res.AutoScalingReservationSet) == 0 {
+			return fmt.Errorf("auto scaling group does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		foundGroup := res.AutoScalingReservationSet[0]
+
+		if nifcloud.ToString(foundGroup.AutoScalingGroupName) != saved.Primary.ID {
+			return fmt.Errorf("auto scaling group does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		*group = foundGroup
+		return nil
+	}
+}
+
+func testAccCheckAutoScalingGroupValues(group *types.AutoScalingReservationSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(group.AutoScalingGroupName) != rName {
+			return fmt.Errorf("bad name state, expected \"%s\", got: %#v", rName, group.AutoScalingGroupName)
+		}
+
+		if nifcloud.ToInt32(group.MinSize) != 1 {
+			return fmt.Errorf("bad min_size state, expected 1, got: %#v", group.MinSize)
+		}
+
+		if nifcloud.ToInt32(group.MaxSize) != 2 {
+			return fmt.Errorf("bad max_size state, expected 2, got: %#v", group.MaxSize)
+		}
+
+		if len(group.TriggerSet) != 1 || nifcloud.ToString(group.TriggerSet[0].Resource) != "Server-cpu" {
+			return fmt.Errorf("bad trigger state, expected \"Server-cpu\", got: %#v", group.TriggerSet)
+		}
+
+		if nifcloud.ToString(group.Description) != "memo" {
+			return fmt.Errorf("bad description state, expected \"memo\", got: %#v", group.Description)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAutoScalingGroupValuesUpdated(group *types.AutoScalingReservationSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(group.AutoScalingGroupName) != rName+"upd" {
+			return fmt.Errorf("bad name state, expected \"%s\", got: %#v", rName+"upd", group.AutoScalingGroupName)
+		}
+
+		if nifcloud.ToInt32(group.MaxSize) != 3 {
+			return fmt.Errorf("bad max_size state, expected 3, got: %#v", group.MaxSize)
+		}
+
+		if nifcloud.ToString(group.Description) != "memo-upd" {
+			return fmt.Errorf("bad description state, expected \"memo-upd\", got: %#v", group.Description)
+		}
+		return nil
+	}
+}
+
+func testAccAutoScalingGroupResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_auto_scaling_group" {
+			continue
+		}
+
+		res, err := svc.NiftyDescribeAutoScalingGroups(context.Background(), &computing.NiftyDescribeAutoScalingGroupsInput{
+			AutoScalingGroupName: []string{rs.Primary.ID},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.AutoScalingGroup" {
+				return nil
+			}
+			return fmt.Errorf("failed NiftyDescribeAutoScalingGroupsRequest: %s", err)
+		}
+
+		if len(res.AutoScalingReservationSet) > 0 {
+			return fmt.Errorf("auto scaling group (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testSweepAutoScalingGroup(region string) error {
+	ctx := context.Background()
+	svc := sharedClientForRegion(region).Computing
+
+	res, err := svc.NiftyDescribeAutoScalingGroups(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var sweepGroups []string
+	for _, g := range res.AutoScalingReservationSet {
+		if strings.HasPrefix(nifcloud.ToString(g.AutoScalingGroupName), prefix) {
+			sweepGroups = append(sweepGroups, nifcloud.ToString(g.AutoScalingGroupName))
+		}
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, n := range sweepGroups {
+		groupName := n
+		eg.Go(func() error {
+			_, err := svc.NiftyDeleteAutoScalingGroup(ctx, &computing.NiftyDeleteAutoScalingGroupInput{
+				AutoScalingGroupName: nifcloud.String(groupName),
+			})
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package acc
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceAvailabilityZones_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_availability_zones.basic"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailabilityZonesDataSource(t, "testdata/data_availability_zones.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "names.*", "east-11"),
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "zones.*", map[string]string{
+						"name":        "east-11",
+						"region_name": "jp-east-1",
+						"state":       "available",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAvailabilityZonesDataSource(t *testing.T, fileName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
@@ -0,0 +1,97 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func TestAcc_ElasticIPAssociation(t *testing.T) {
+	var elasticIP types.AddressesSet
+
+	resourceName := "nifcloud_elastic_ip_association.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccElasticIPAssociationResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccElasticIPAssociation(t, "testdata/elastic_ip_association.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckElasticIPExists(resourceName, &elasticIP),
+					testAccCheckElasticIPAssociationValues(&elasticIP, randName),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", "nifcloud_elastic_ip.basic", "public_ip"),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", randName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"reboot",
+				},
+			},
+		},
+	})
+}
+
+func testAccElasticIPAssociation(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckElasticIPAssociationValues(elasticIP *types.AddressesSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(elasticIP.InstanceId) != rName {
+			return fmt.Errorf("bad instance_id state, expected \"%s\", got: %#v", rName, elasticIP.InstanceId)
+		}
+		return nil
+	}
+}
+
+func testAccElasticIPAssociationResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_elastic_ip_association" {
+			continue
+		}
+
+		res, err := svc.DescribeAddresses(context.Background(), &computing.DescribeAddressesInput{
+			PublicIp: []string{rs.Primary.ID},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.IpAddress" {
+				return nil
+			}
+			return fmt.Errorf("failed DescribeAddressesRequest: %s", err)
+		}
+
+		if len(res.AddressesSet) > 0 && nifcloud.ToString(res.AddressesSet[0].InstanceId) != "" {
+			return fmt.Errorf("elastic ip association (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func TestAcc_ELBInstanceAttachment(t *testing.T) {
+	resourceName := "nifcloud_elb_instance_attachment.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccELBInstanceAttachmentResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccELBInstanceAttachment(t, "testdata/elb_instance_attachment.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckELBInstanceAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "elb_id"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "lb_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "instance_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", randName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"wait_for_healthy",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatasourceELBInstanceHealth_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_elb_instance_health.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccELBInstanceAttachmentResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccELBInstanceAttachment(t, "testdata/data_elb_instance_health.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "instance_states.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "instance_states.0.instance_id", randName),
+					resource.TestCheckResourceAttr(datasourceName, "instance_states.0.state", "InService"),
+					resource.TestCheckResourceAttr(datasourceName, "all_in_service", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccELBInstanceAttachment(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckELBInstanceAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no elb instance attachment resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no elb instance attachment id is set")
+		}
+
+		registered, err := testAccELBInstanceRegistered(saved)
+		if err != nil {
+			return err
+		}
+
+		if !registered {
+			return fmt.Errorf("elb instance attachment does not found in cloud: %s", saved.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccELBInstanceAttachmentResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_elb_instance_attachment" {
+			continue
+		}
+
+		registered, err := testAccELBInstanceRegistered(rs)
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.ElasticLoadBalancerId" {
+				return nil
+			}
+			return fmt.Errorf("failed NiftyDescribeElasticLoadBalancersRequest: %s", err)
+		}
+
+		if registered {
+			return fmt.Errorf("elb instance attachment (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccELBInstanceRegistered(rs *terraform.ResourceState) (bool, error) {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	lbPort, err := strconv.Atoi(rs.Primary.Attributes["lb_port"])
+	if err != nil {
+		return false, err
+	}
+	instancePort, err := strconv.Atoi(rs.Primary.Attributes["instance_port"])
+	if err != nil {
+		return false, err
+	}
+
+	res, err := svc.NiftyDescribeElasticLoadBalancers(context.Background(), &computing.NiftyDescribeElasticLoadBalancersInput{
+		ElasticLoadBalancers: &types.RequestElasticLoadBalancers{
+			ListOfRequestElasticLoadBalancerId:   []string{rs.Primary.Attributes["elb_id"]},
+			ListOfRequestElasticLoadBalancerPort: []int32{int32(lbPort)},
+			ListOfRequestInstancePort:            []int32{int32(instancePort)},
+			ListOfRequestProtocol:                []string{rs.Primary.Attributes["protocol"]},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, elb := range res.NiftyDescribeElasticLoadBalancersResult.ElasticLoadBalancerDescriptions {
+		for _, l := range elb.ElasticLoadBalancerListenerDescriptions {
+			for _, instance := range l.Listener.Instances {
+				if nifcloud.ToString(instance.InstanceId) == rs.Primary.Attributes["instance_id"] {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
@@ -86,6 +86,35 @@ func TestAcc_HatobaCluster(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceHatobaCluster_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_hatoba_cluster.basic"
+	resourceName := "nifcloud_hatoba_cluster.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccHatobaClusterResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHatobaCluster(t, "testdata/data_hatoba_cluster.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(datasourceName, "kubernetes_version", resourceName, "kubernetes_version"),
+					resource.TestCheckResourceAttrPair(datasourceName, "firewall_group", resourceName, "firewall_group"),
+					resource.TestCheckResourceAttr(datasourceName, "locations.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "locations.0", "east-21"),
+					resource.TestCheckResourceAttr(datasourceName, "network_config.0.network_id", "net-COMMON_PRIVATE"),
+					resource.TestCheckResourceAttr(datasourceName, "node_pools.#", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "kube_config_raw"),
+				),
+			},
+		},
+	})
+}
+
 func fetchDefaultKubernetesVersion(region string) (string, error) {
 	svc := sharedClientForRegion(region).Hatoba
 	res, err := svc.GetServerConfig(context.Background(), nil)
@@ -1,14 +1,84 @@
 package acc
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"strings"
 	"testing"
 
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"golang.org/x/sync/errgroup"
 )
 
+func init() {
+	resource.AddTestSweepers("nifcloud_image", &resource.Sweeper{
+		Name: "nifcloud_image",
+		F:    testSweepImage,
+		Dependencies: []string{
+			"nifcloud_auto_scaling_group",
+		},
+	})
+}
+
+func TestAcc_Image(t *testing.T) {
+	var image types.ImagesSet
+
+	resourceName := "nifcloud_image.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccImageResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImage(t, "testdata/image.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(resourceName, &image),
+					testAccCheckImageValues(&image, randName),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", randName),
+					resource.TestCheckResourceAttr(resourceName, "image_name", randName),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(resourceName, "left_instance", "true"),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(resourceName, "image_state", "available"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_id"),
+				),
+			},
+			{
+				Config: testAccImage(t, "testdata/image_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(resourceName, &image),
+					testAccCheckImageValuesUpdated(&image, randName),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", randName),
+					resource.TestCheckResourceAttr(resourceName, "image_name", randName+"upd"),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo-upd"),
+					resource.TestCheckResourceAttr(resourceName, "left_instance", "true"),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone", "east-21"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"instance_id",
+					"left_instance",
+				},
+			},
+		},
+	})
+}
+
 func TestAccDatasourceImage_basic(t *testing.T) {
 	datasourceName := "data.nifcloud_image.basic"
 
@@ -30,6 +100,19 @@ func TestAccDatasourceImage_basic(t *testing.T) {
 	})
 }
 
+func testAccImage(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
 func testAccImageDataSource(t *testing.T, fileName string) string {
 	b, err := ioutil.ReadFile(fileName)
 	if err != nil {
@@ -51,3 +134,127 @@ func testAccCheckImageDataSourceID(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func testAccCheckImageExists(n string, image *types.ImagesSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no image resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no image id is set")
+		}
+
+		svc := testAccProvider.Meta().(*client.Client).Computing
+		res, err := svc.DescribeImages(context.Background(), &computing.DescribeImagesInput{
+			ImageId: []string{saved.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+
+		if res == nil || len(res.ImagesSet) == 0 {
+			return fmt.Errorf("image does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		foundImage := res.ImagesSet[0]
+
+		if nifcloud.ToString(foundImage.ImageId) != saved.Primary.ID {
+			return fmt.Errorf("image does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		*image = foundImage
+		return nil
+	}
+}
+
+func testAccCheckImageValues(image *types.ImagesSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(image.Name) != rName {
+			return fmt.Errorf("bad image_name state, expected \"%s\", got: %#v", rName, image.Name)
+		}
+
+		if nifcloud.ToString(image.Description) != "memo" {
+			return fmt.Errorf("bad description state, expected \"memo\", got: %#v", image.Description)
+		}
+
+		if nifcloud.ToString(image.Placement.AvailabilityZone) != "east-21" {
+			return fmt.Errorf("bad availability_zone state, expected \"east-21\", got: %#v", image.Placement.AvailabilityZone)
+		}
+		return nil
+	}
+}
+
+func testAccCheckImageValuesUpdated(image *types.ImagesSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(image.Name) != rName+"upd" {
+			return fmt.Errorf("bad image_name state, expected \"%s\", got: %#v", rName+"upd", image.Name)
+		}
+
+		if nifcloud.ToString(image.Description) != "memo-upd" {
+			return fmt.Errorf("bad description state, expected \"memo-upd\", got: %#v", image.Description)
+		}
+		return nil
+	}
+}
+
+func testAccImageResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_image" {
+			continue
+		}
+
+		res, err := svc.DescribeImages(context.Background(), &computing.DescribeImagesInput{
+			ImageId: []string{rs.Primary.ID},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Image" {
+				return nil
+			}
+			return fmt.Errorf("failed DescribeImagesRequest: %s", err)
+		}
+
+		if len(res.ImagesSet) > 0 {
+			return fmt.Errorf("image (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testSweepImage(region string) error {
+	ctx := context.Background()
+	svc := sharedClientForRegion(region).Computing
+
+	res, err := svc.DescribeImages(ctx, &computing.DescribeImagesInput{
+		Owner: []string{"self"},
+	})
+	if err != nil {
+		return err
+	}
+
+	var sweepImages []string
+	for _, i := range res.ImagesSet {
+		if strings.HasPrefix(nifcloud.ToString(i.Name), prefix) {
+			sweepImages = append(sweepImages, nifcloud.ToString(i.ImageId))
+		}
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, n := range sweepImages {
+		imageID := n
+		eg.Go(func() error {
+			_, err := svc.DeleteImage(ctx, &computing.DeleteImageInput{
+				ImageId: nifcloud.String(imageID),
+			})
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"golang.org/x/sync/errgroup"
+)
+
+func init() {
+	resource.AddTestSweepers("nifcloud_instance_backup_rule", &resource.Sweeper{
+		Name: "nifcloud_instance_backup_rule",
+		F:    testSweepInstanceBackupRule,
+	})
+}
+
+func TestAcc_InstanceBackupRule(t *testing.T) {
+	var rule types.InstanceBackupRulesSet
+
+	resourceName := "nifcloud_instance_backup_rule.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccInstanceBackupRuleResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceBackupRule(t, "testdata/instance_backup_rule.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceBackupRuleExists(resourceName, &rule),
+					testAccCheckInstanceBackupRuleValues(&rule, randName),
+					resource.TestCheckResourceAttr(resourceName, "name", randName),
+					resource.TestCheckResourceAttr(resourceName, "instance_unique_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "time_slot_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backup_instance_max_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_backup_rule_id"),
+				),
+			},
+			{
+				Config: testAccInstanceBackupRule(t, "testdata/instance_backup_rule_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceBackupRuleExists(resourceName, &rule),
+					testAccCheckInstanceBackupRuleValuesUpdated(&rule, randName),
+					resource.TestCheckResourceAttr(resourceName, "name", randName+"upd"),
+					resource.TestCheckResourceAttr(resourceName, "instance_unique_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "time_slot_id", "12"),
+					resource.TestCheckResourceAttr(resourceName, "backup_instance_max_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo-upd"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccInstanceBackupRule(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckInstanceBackupRuleExists(n string, rule *types.InstanceBackupRulesSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no instance backup rule resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no instance backup rule id is set")
+		}
+
+		svc := testAccProvider.Meta().(*client.Client).Computing
+		res, err := svc.DescribeInstanceBackupRules(context.Background(), &computing.DescribeInstanceBackupRulesInput{
+			InstanceBackupRuleId: []string{saved.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+
+		if res == nil || len(res.InstanceBackupRulesSet) == 0 {
+			return fmt.Errorf("instance backup rule does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		foundRule := res.InstanceBackupRulesSet[0]
+
+		if nifcloud.ToString(foundRule.InstanceBackupRuleId) != saved.Primary.ID {
+			return fmt.Errorf("instance backup rule does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		*rule = foundRule
+		return nil
+	}
+}
+
+func testAccCheckInstanceBackupRuleValues(rule *types.InstanceBackupRulesSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(rule.InstanceBackupRuleName) != rName {
+			return fmt.Errorf("bad name state, expected \"%s\", got: %#v", rName, rule.InstanceBackupRuleName)
+		}
+
+		if len(rule.InstancesSet) != 1 || nifcloud.ToString(rule.InstancesSet[0].InstanceId) != rName {
+			return fmt.Errorf("bad instances state, expected \"%s\", got: %#v", rName, rule.InstancesSet)
+		}
+
+		if nifcloud.ToString(rule.TimeSlotId) != "1" {
+			return fmt.Errorf("bad time_slot_id state, expected \"1\", got: %#v", rule.TimeSlotId)
+		}
+
+		if nifcloud.ToInt32(rule.BackupInstanceMaxCount) != 2 {
+			return fmt.Errorf("bad backup_instance_max_count state, expected 2, got: %#v", rule.BackupInstanceMaxCount)
+		}
+
+		if nifcloud.ToString(rule.Description) != "memo" {
+			return fmt.Errorf("bad description state, expected \"memo\", got: %#v", rule.Description)
+		}
+		return nil
+	}
+}
+
+func testAccCheckInstanceBackupRuleValuesUpdated(rule *types.InstanceBackupRulesSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(rule.InstanceBackupRuleName) != rName+"upd" {
+			return fmt.Errorf("bad name state, expected \"%s\", got: %#v", rName+"upd", rule.InstanceBackupRuleName)
+		}
+
+		if nifcloud.ToString(rule.TimeSlotId) != "12" {
+			return fmt.Errorf("bad time_slot_id state, expected \"12\", got: %#v", rule.TimeSlotId)
+		}
+
+		if nifcloud.ToInt32(rule.BackupInstanceMaxCount) != 3 {
+			return fmt.Errorf("bad backup_instance_max_count state, expected 3, got: %#v", rule.BackupInstanceMaxCount)
+		}
+
+		if nifcloud.ToString(rule.Description) != "memo-upd" {
+			return fmt.Errorf("bad description state, expected \"memo-upd\", got: %#v", rule.Description)
+		}
+		return nil
+	}
+}
+
+func testAccInstanceBackupRuleResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_instance_backup_rule" {
+			continue
+		}
+
+		res, err := svc.DescribeInstanceBackupRules(context.Background(), &computing.DescribeInstanceBackupRulesInput{
+			InstanceBackupRuleId: []string{rs.Primary.ID},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.InstanceBackupRuleId" {
+				return nil
+			}
+			return fmt.Errorf("failed DescribeInstanceBackupRulesRequest: %s", err)
+		}
+
+		if len(res.InstanceBackupRulesSet) > 0 {
+			return fmt.Errorf("instance backup rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testSweepInstanceBackupRule(region string) error {
+	ctx := context.Background()
+	svc := sharedClientForRegion(region).Computing
+
+	res, err := svc.DescribeInstanceBackupRules(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var sweepRules []string
+	for _, r := range res.InstanceBackupRulesSet {
+		if strings.HasPrefix(nifcloud.ToString(r.InstanceBackupRuleName), prefix) {
+			sweepRules = append(sweepRules, nifcloud.ToString(r.InstanceBackupRuleId))
+		}
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, n := range sweepRules {
+		ruleID := n
+		eg.Go(func() error {
+			_, err := svc.DeleteInstanceBackupRule(ctx, &computing.DeleteInstanceBackupRuleInput{
+				InstanceBackupRuleId: nifcloud.String(ruleID),
+			})
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return nil
+}
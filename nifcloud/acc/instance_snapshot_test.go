@@ -0,0 +1,245 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"golang.org/x/sync/errgroup"
+)
+
+func init() {
+	resource.AddTestSweepers("nifcloud_instance_snapshot", &resource.Sweeper{
+		Name: "nifcloud_instance_snapshot",
+		F:    testSweepInstanceSnapshot,
+	})
+}
+
+func TestAcc_InstanceSnapshot(t *testing.T) {
+	var snapshot types.SnapshotInfoSet
+
+	resourceName := "nifcloud_instance_snapshot.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccInstanceSnapshotResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceSnapshot(t, "testdata/instance_snapshot.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceSnapshotExists(resourceName, &snapshot),
+					testAccCheckInstanceSnapshotValues(&snapshot, randName),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", randName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_name", randName),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(resourceName, "status", "normal"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_snapshot_id"),
+				),
+			},
+			{
+				Config: testAccInstanceSnapshot(t, "testdata/instance_snapshot_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceSnapshotExists(resourceName, &snapshot),
+					testAccCheckInstanceSnapshotValuesUpdated(&snapshot, randName),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo-upd"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"restore_trigger",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatasourceInstanceSnapshots_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_instance_snapshots.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccInstanceSnapshotResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceSnapshot(t, "testdata/data_instance_snapshots.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "instance_id", randName),
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "snapshots.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "snapshots.0.snapshot_name", randName),
+					resource.TestCheckResourceAttr(datasourceName, "snapshots.0.description", "memo"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ids.0", "nifcloud_instance_snapshot.basic", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceSnapshot(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckInstanceSnapshotExists(n string, snapshot *types.SnapshotInfoSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no instance snapshot resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no instance snapshot id is set")
+		}
+
+		svc := testAccProvider.Meta().(*client.Client).Computing
+		res, err := svc.NiftyDescribeInstanceSnapshots(context.Background(), &computing.NiftyDescribeInstanceSnapshotsInput{
+			InstanceSnapshotId: []string{saved.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+
+		if res == nil || len(res.SnapshotInfoSet) == 0 {
+			return fmt.Errorf("instance snapshot does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		foundSnapshot := res.SnapshotInfoSet[0]
+
+		if nifcloud.ToString(foundSnapshot.InstanceSnapshotId) != saved.Primary.ID {
+			return fmt.Errorf("instance snapshot does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		*snapshot = foundSnapshot
+		return nil
+	}
+}
+
+func testAccCheckInstanceSnapshotValues(snapshot *types.SnapshotInfoSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(snapshot.InstanceId) != rName {
+			return fmt.Errorf("bad instance_id state, expected \"%s\", got: %#v", rName, snapshot.InstanceId)
+		}
+
+		if nifcloud.ToString(snapshot.SnapshotName) != rName {
+			return fmt.Errorf("bad snapshot_name state, expected \"%s\", got: %#v", rName, snapshot.SnapshotName)
+		}
+
+		if nifcloud.ToString(snapshot.Memo) != "memo" {
+			return fmt.Errorf("bad description state, expected \"memo\", got: %#v", snapshot.Memo)
+		}
+		return nil
+	}
+}
+
+func testAccCheckInstanceSnapshotValuesUpdated(snapshot *types.SnapshotInfoSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(snapshot.SnapshotName) != rName {
+			return fmt.Errorf("bad snapshot_name state, expected \"%s\", got: %#v", rName, snapshot.SnapshotName)
+		}
+
+		if nifcloud.ToString(snapshot.Memo) != "memo-upd" {
+			return fmt.Errorf("bad description state, expected \"memo-upd\", got: %#v", snapshot.Memo)
+		}
+		return nil
+	}
+}
+
+func testAccInstanceSnapshotResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_instance_snapshot" {
+			continue
+		}
+
+		res, err := svc.NiftyDescribeInstanceSnapshots(context.Background(), &computing.NiftyDescribeInstanceSnapshotsInput{
+			InstanceSnapshotId: []string{rs.Primary.ID},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Snapshot" {
+				return nil
+			}
+			return fmt.Errorf("failed NiftyDescribeInstanceSnapshotsRequest: %s", err)
+		}
+
+		if len(res.SnapshotInfoSet) > 0 {
+			return fmt.Errorf("instance snapshot (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testSweepInstanceSnapshot(region string) error {
+	ctx := context.Background()
+	svc := sharedClientForRegion(region).Computing
+
+	res, err := svc.NiftyDescribeInstanceSnapshots(ctx, nil)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Snapshot" {
+			return nil
+		}
+		return err
+	}
+
+	var sweepSnapshots []string
+	for _, s := range res.SnapshotInfoSet {
+		if strings.HasPrefix(nifcloud.ToString(s.SnapshotName), prefix) {
+			sweepSnapshots = append(sweepSnapshots, nifcloud.ToString(s.InstanceSnapshotId))
+		}
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, n := range sweepSnapshots {
+		snapshotID := n
+		eg.Go(func() error {
+			_, err := svc.NiftyDeleteInstanceSnapshot(ctx, &computing.NiftyDeleteInstanceSnapshotInput{
+				InstanceSnapshotId: nifcloud.String(snapshotID),
+			})
+			if err != nil {
+				return err
+			}
+
+			err = computing.NewSnapshotDeletedWaiter(svc).Wait(ctx, &computing.NiftyDescribeInstanceSnapshotsInput{
+				InstanceSnapshotId: []string{snapshotID},
+			}, 600*time.Second)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return nil
+}
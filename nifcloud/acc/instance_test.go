@@ -26,6 +26,9 @@ func init() {
 		F:    testSweepInstance,
 		Dependencies: []string{
 			"nifcloud_volume",
+			"nifcloud_instance_backup_rule",
+			"nifcloud_instance_snapshot",
+			"nifcloud_alarm",
 		},
 	})
 }
@@ -0,0 +1,36 @@
+package acc
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceInstanceTypes_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_instance_types.basic"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypesDataSource(t, "testdata/data_instance_types.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "instance_types.*", "e-large"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "instance_types.*", "e-mini"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceTypesDataSource(t *testing.T, fileName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
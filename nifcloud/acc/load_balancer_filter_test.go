@@ -0,0 +1,142 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func TestAcc_LoadBalancerFilter(t *testing.T) {
+	resourceName := "nifcloud_load_balancer_filter.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccLoadBalancerFilterResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerFilter(t, "testdata/load_balancer_filter.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_name", randName),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "instance_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "192.0.2.1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLoadBalancerFilter(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+	)
+}
+
+func testAccCheckLoadBalancerFilterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no load balancer filter resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no load balancer filter id is set")
+		}
+
+		added, err := testAccLoadBalancerFilterAdded(saved)
+		if err != nil {
+			return err
+		}
+
+		if !added {
+			return fmt.Errorf("load balancer filter does not found in cloud: %s", saved.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccLoadBalancerFilterResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_load_balancer_filter" {
+			continue
+		}
+
+		added, err := testAccLoadBalancerFilterAdded(rs)
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.LoadBalancerName" {
+				return nil
+			}
+			return fmt.Errorf("failed DescribeLoadBalancersRequest: %s", err)
+		}
+
+		if added {
+			return fmt.Errorf("load balancer filter (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccLoadBalancerFilterAdded(rs *terraform.ResourceState) (bool, error) {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	lbPort, err := strconv.Atoi(rs.Primary.Attributes["load_balancer_port"])
+	if err != nil {
+		return false, err
+	}
+	instancePort, err := strconv.Atoi(rs.Primary.Attributes["instance_port"])
+	if err != nil {
+		return false, err
+	}
+
+	res, err := svc.DescribeLoadBalancers(context.Background(), &computing.DescribeLoadBalancersInput{
+		LoadBalancerNames: &types.ListOfRequestLoadBalancerNames{
+			Member: []types.RequestLoadBalancerNames{
+				{
+					LoadBalancerName: nifcloud.String(rs.Primary.Attributes["load_balancer_name"]),
+					LoadBalancerPort: nifcloud.Int32(int32(lbPort)),
+					InstancePort:     nifcloud.Int32(int32(instancePort)),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, lb := range res.DescribeLoadBalancersResult.LoadBalancerDescriptions {
+		if lb.Filter == nil {
+			continue
+		}
+		for _, filter := range lb.Filter.IPAddresses {
+			if nifcloud.ToString(filter.IPAddress) == rs.Primary.Attributes["ip_address"] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,166 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func TestAcc_LoadBalancerInstanceAttachment(t *testing.T) {
+	resourceName := "nifcloud_load_balancer_instance_attachment.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccLoadBalancerInstanceAttachmentResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerInstanceAttachment(t, "testdata/load_balancer_instance_attachment.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerInstanceAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_name", randName),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "instance_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", randName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"wait_for_healthy",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatasourceLoadBalancerInstanceHealth_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_load_balancer_instance_health.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccLoadBalancerInstanceAttachmentResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerInstanceAttachment(t, "testdata/data_load_balancer_instance_health.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "instance_states.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "instance_states.0.instance_id", randName),
+					resource.TestCheckResourceAttr(datasourceName, "instance_states.0.state", "InService"),
+					resource.TestCheckResourceAttr(datasourceName, "all_in_service", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLoadBalancerInstanceAttachment(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckLoadBalancerInstanceAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no load balancer instance attachment resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no load balancer instance attachment id is set")
+		}
+
+		registered, err := testAccLoadBalancerInstanceRegistered(saved)
+		if err != nil {
+			return err
+		}
+
+		if !registered {
+			return fmt.Errorf("load balancer instance attachment does not found in cloud: %s", saved.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccLoadBalancerInstanceAttachmentResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_load_balancer_instance_attachment" {
+			continue
+		}
+
+		registered, err := testAccLoadBalancerInstanceRegistered(rs)
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.LoadBalancerName" {
+				return nil
+			}
+			return fmt.Errorf("failed DescribeLoadBalancersRequest: %s", err)
+		}
+
+		if registered {
+			return fmt.Errorf("load balancer instance attachment (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccLoadBalancerInstanceRegistered(rs *terraform.ResourceState) (bool, error) {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	lbPort, err := strconv.Atoi(rs.Primary.Attributes["load_balancer_port"])
+	if err != nil {
+		return false, err
+	}
+	instancePort, err := strconv.Atoi(rs.Primary.Attributes["instance_port"])
+	if err != nil {
+		return false, err
+	}
+
+	res, err := svc.DescribeLoadBalancers(context.Background(), &computing.DescribeLoadBalancersInput{
+		LoadBalancerNames: &types.ListOfRequestLoadBalancerNames{
+			Member: []types.RequestLoadBalancerNames{
+				{
+					LoadBalancerName: nifcloud.String(rs.Primary.Attributes["load_balancer_name"]),
+					LoadBalancerPort: nifcloud.Int32(int32(lbPort)),
+					InstancePort:     nifcloud.Int32(int32(instancePort)),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, lb := range res.DescribeLoadBalancersResult.LoadBalancerDescriptions {
+		for _, instance := range lb.Instances {
+			if nifcloud.ToString(instance.InstanceId) == rs.Primary.Attributes["instance_id"] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
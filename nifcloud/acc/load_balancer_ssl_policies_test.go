@@ -0,0 +1,37 @@
+package acc
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceLoadBalancerSSLPolicies_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_load_balancer_ssl_policies.basic"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerSSLPoliciesDataSource(t, "testdata/data_load_balancer_ssl_policies.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ssl_policies.0.ssl_policy_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ssl_policies.0.ssl_policy_name"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ssl_policies.0.ciphers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLoadBalancerSSLPoliciesDataSource(t *testing.T, fileName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
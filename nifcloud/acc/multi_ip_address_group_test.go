@@ -0,0 +1,224 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"golang.org/x/sync/errgroup"
+)
+
+func init() {
+	resource.AddTestSweepers("nifcloud_multi_ip_address_group", &resource.Sweeper{
+		Name: "nifcloud_multi_ip_address_group",
+		F:    testSweepMultiIPAddressGroup,
+		Dependencies: []string{
+			"nifcloud_instance",
+		},
+	})
+}
+
+func TestAcc_MultiIPAddressGroup(t *testing.T) {
+	var group types.MultiIpAddressGroupsSet
+
+	resourceName := "nifcloud_multi_ip_address_group.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccMultiIPAddressGroupResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiIPAddressGroup(t, "testdata/multi_ip_address_group.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiIPAddressGroupExists(resourceName, &group),
+					testAccCheckMultiIPAddressGroupValues(&group, randName),
+					resource.TestCheckResourceAttr(resourceName, "name", randName),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "multi_ip_address_group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_gateway"),
+					resource.TestCheckResourceAttrSet(resourceName, "subnet_mask"),
+					resource.TestCheckTypeSetElemAttrPair("nifcloud_instance.basic", "network_interface.*.multi_ip_address_group_id", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccMultiIPAddressGroup(t, "testdata/multi_ip_address_group_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiIPAddressGroupExists(resourceName, &group),
+					testAccCheckMultiIPAddressGroupValuesUpdated(&group, randName),
+					resource.TestCheckResourceAttr(resourceName, "name", randName+"upd"),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo-upd"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMultiIPAddressGroup(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckMultiIPAddressGroupExists(n string, group *types.MultiIpAddressGroupsSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no multi ip address group resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no multi ip address group id is set")
+		}
+
+		svc := testAccProvider.Meta().(*client.Client).Computing
+		res, err := svc.DescribeMultiIpAddressGroups(context.Background(), &computing.DescribeMultiIpAddressGroupsInput{
+			MultiIpAddressGroupId: []string{saved.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+
+		if res == nil || len(res.MultiIpAddressGroupsSet) == 0 {
+			return fmt.Errorf("multi ip address group does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		foundGroup := res.MultiIpAddressGroupsSet[0]
+
+		if nifcloud.ToString(foundGroup.MultiIpAddressGroupId) != saved.Primary.ID {
+			return fmt.Errorf("multi ip address group does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		*group = foundGroup
+		return nil
+	}
+}
+
+func testAccCheckMultiIPAddressGroupValues(group *types.MultiIpAddressGroupsSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(group.MultiIpAddressGroupName) != rName {
+			return fmt.Errorf("bad name state, expected \"%s\", got: %#v", rName, group.MultiIpAddressGroupName)
+		}
+
+		if nifcloud.ToString(group.Description) != "memo" {
+			return fmt.Errorf("bad description state, expected \"memo\", got: %#v", group.Description)
+		}
+
+		if nifcloud.ToString(group.AvailabilityZone) != "east-21" {
+			return fmt.Errorf("bad availability_zone state, expected \"east-21\", got: %#v", group.AvailabilityZone)
+		}
+
+		if group.MultiIpAddressNetwork == nil || len(group.MultiIpAddressNetwork.IpAddressesSet) != 1 {
+			return fmt.Errorf("bad ip_addresses state, expected 1 address, got: %#v", group.MultiIpAddressNetwork)
+		}
+
+		if len(group.InstancesSet) != 1 || nifcloud.ToString(group.InstancesSet[0].InstanceId) != rName {
+			return fmt.Errorf("bad instances state, expected instance %q, got: %#v", rName, group.InstancesSet)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMultiIPAddressGroupValuesUpdated(group *types.MultiIpAddressGroupsSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(group.MultiIpAddressGroupName) != rName+"upd" {
+			return fmt.Errorf("bad name state, expected \"%s\", got: %#v", rName+"upd", group.MultiIpAddressGroupName)
+		}
+
+		if nifcloud.ToString(group.Description) != "memo-upd" {
+			return fmt.Errorf("bad description state, expected \"memo-upd\", got: %#v", group.Description)
+		}
+
+		if group.MultiIpAddressNetwork == nil || len(group.MultiIpAddressNetwork.IpAddressesSet) != 2 {
+			return fmt.Errorf("bad ip_addresses state, expected 2 addresses, got: %#v", group.MultiIpAddressNetwork)
+		}
+		return nil
+	}
+}
+
+func testAccMultiIPAddressGroupResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_multi_ip_address_group" {
+			continue
+		}
+
+		res, err := svc.DescribeMultiIpAddressGroups(context.Background(), &computing.DescribeMultiIpAddressGroupsInput{
+			MultiIpAddressGroupId: []string{rs.Primary.ID},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.MultiIpAddressGroupId" {
+				return nil
+			}
+			return fmt.Errorf("failed DescribeMultiIpAddressGroupsRequest: %s", err)
+		}
+
+		if len(res.MultiIpAddressGroupsSet) > 0 {
+			return fmt.Errorf("multi ip address group (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testSweepMultiIPAddressGroup(region string) error {
+	ctx := context.Background()
+	svc := sharedClientForRegion(region).Computing
+
+	res, err := svc.DescribeMultiIpAddressGroups(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var sweepGroups []string
+	for _, g := range res.MultiIpAddressGroupsSet {
+		if strings.HasPrefix(nifcloud.ToString(g.MultiIpAddressGroupName), prefix) {
+			sweepGroups = append(sweepGroups, nifcloud.ToString(g.MultiIpAddressGroupId))
+		}
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, id := range sweepGroups {
+		groupID := id
+		eg.Go(func() error {
+			_, err := svc.DeleteMultiIpAddressGroup(ctx, &computing.DeleteMultiIpAddressGroupInput{
+				MultiIpAddressGroupId: nifcloud.String(groupID),
+			})
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func TestAcc_NatRule(t *testing.T) {
+	snatResourceName := "nifcloud_nat_rule.snat"
+	dnatResourceName := "nifcloud_nat_rule.dnat"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccNatRuleResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatRule(t, "testdata/nat_rule.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatRuleExists(snatResourceName),
+					resource.TestCheckResourceAttr(snatResourceName, "nat_type", "snat"),
+					resource.TestCheckResourceAttr(snatResourceName, "rule_number", "1"),
+					resource.TestCheckResourceAttr(snatResourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(snatResourceName, "protocol", "ALL"),
+					resource.TestCheckResourceAttr(snatResourceName, "source_address", "192.168.1.10"),
+					resource.TestCheckResourceAttr(snatResourceName, "outbound_interface_network_id", "net-COMMON_GLOBAL"),
+					testAccCheckNatRuleExists(dnatResourceName),
+					resource.TestCheckResourceAttr(dnatResourceName, "nat_type", "dnat"),
+					resource.TestCheckResourceAttr(dnatResourceName, "rule_number", "1"),
+					resource.TestCheckResourceAttr(dnatResourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(dnatResourceName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(dnatResourceName, "destination_port", "8080"),
+					resource.TestCheckResourceAttr(dnatResourceName, "translation_address", "192.168.1.10"),
+					resource.TestCheckResourceAttr(dnatResourceName, "translation_port", "80"),
+					resource.TestCheckResourceAttr(dnatResourceName, "inbound_interface_network_name", randName),
+				),
+			},
+			{
+				Config: testAccNatRule(t, "testdata/nat_rule_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatRuleExists(snatResourceName),
+					resource.TestCheckResourceAttr(snatResourceName, "description", "memo-upd"),
+					testAccCheckNatRuleExists(dnatResourceName),
+					resource.TestCheckResourceAttr(dnatResourceName, "description", "memo-upd"),
+				),
+			},
+			{
+				ResourceName:      snatResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      dnatResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"inbound_interface_network_id",
+					"inbound_interface_network_name",
+				},
+			},
+		},
+	})
+}
+
+func testAccNatRule(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+	)
+}
+
+func testAccCheckNatRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no nat rule resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no nat rule id is set")
+		}
+
+		found, err := testAccNatRuleFound(saved)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("nat rule does not found in cloud: %s", saved.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccNatRuleResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_nat_rule" {
+			continue
+		}
+
+		found, err := testAccNatRuleFound(rs)
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.NatTableId" {
+				return nil
+			}
+			return fmt.Errorf("failed NiftyDescribeNatTablesRequest: %s", err)
+		}
+
+		if found {
+			return fmt.Errorf("nat rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNatRuleFound(rs *terraform.ResourceState) (bool, error) {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	res, err := svc.NiftyDescribeNatTables(context.Background(), &computing.NiftyDescribeNatTablesInput{
+		NatTableId: []string{rs.Primary.Attributes["nat_table_id"]},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, nt := range res.NatTableSet {
+		for _, r := range nt.NatRuleSet {
+			if nifcloud.ToString(r.NatType) == rs.Primary.Attributes["nat_type"] &&
+				nifcloud.ToString(r.RuleNumber) == rs.Primary.Attributes["rule_number"] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
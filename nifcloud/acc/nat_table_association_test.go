@@ -0,0 +1,121 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func TestAcc_NatTableAssociation(t *testing.T) {
+	resourceName := "nifcloud_nat_table_association.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccNatTableAssociationResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatTableAssociation(t, "testdata/nat_table_association.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatTableAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "nat_table_id", "nifcloud_nat_table.first", "nat_table_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "router_id", "nifcloud_router.basic", "router_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "nat_table_association_id"),
+				),
+			},
+			{
+				Config: testAccNatTableAssociation(t, "testdata/nat_table_association_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatTableAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "nat_table_id", "nifcloud_nat_table.second", "nat_table_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "router_id", "nifcloud_router.basic", "router_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "nat_table_association_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNatTableAssociation(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckNatTableAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no NAT table association resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no NAT table association id is set")
+		}
+
+		svc := testAccProvider.Meta().(*client.Client).Computing
+		res, err := svc.NiftyDescribeRouters(context.Background(), &computing.NiftyDescribeRoutersInput{
+			RouterId: []string{saved.Primary.Attributes["router_id"]},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(res.RouterSet) == 0 {
+			return fmt.Errorf("router does not found in cloud: %s", saved.Primary.Attributes["router_id"])
+		}
+
+		if nifcloud.ToString(res.RouterSet[0].NatTableId) != saved.Primary.Attributes["nat_table_id"] {
+			return fmt.Errorf("NAT table association does not found in cloud: %s", saved.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccNatTableAssociationResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_nat_table_association" {
+			continue
+		}
+
+		res, err := svc.NiftyDescribeRouters(context.Background(), &computing.NiftyDescribeRoutersInput{
+			RouterId: []string{rs.Primary.Attributes["router_id"]},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.RouterId" {
+				return nil
+			}
+			return fmt.Errorf("failed NiftyDescribeRoutersRequest: %s", err)
+		}
+
+		if len(res.RouterSet) > 0 && nifcloud.ToString(res.RouterSet[0].NatTableId) != "" {
+			return fmt.Errorf("NAT table association (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
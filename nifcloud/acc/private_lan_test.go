@@ -73,6 +73,38 @@ func TestAcc_PrivateLan(t *testing.T) {
 	})
 }
 
+func TestAcc_PrivateLan_Dependents(t *testing.T) {
+	var privateLan types.PrivateLanSet
+
+	resourceName := "nifcloud_private_lan.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccPrivateLanResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateLanDependents(t, "testdata/private_lan_dependents.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateLanExists(resourceName, &privateLan),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", "192.168.1.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "dependents_outside_cidr.#", "0"),
+				),
+			},
+			{
+				Config: testAccPrivateLanDependents(t, "testdata/private_lan_dependents_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateLanExists(resourceName, &privateLan),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "dependents_outside_cidr.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPrivateLan(t *testing.T, fileName string, rName string) string {
 	b, err := ioutil.ReadFile(fileName)
 	if err != nil {
@@ -83,6 +115,17 @@ func testAccPrivateLan(t *testing.T, fileName string, rName string) string {
 	)
 }
 
+func testAccPrivateLanDependents(t *testing.T, fileName string, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+	)
+}
+
 func testAccPrivateLanResourceDestroy(s *terraform.State) error {
 	svc := testAccProvider.Meta().(*client.Client).Computing
 
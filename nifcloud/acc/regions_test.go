@@ -0,0 +1,39 @@
+package acc
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceRegions_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_regions.basic"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionsDataSource(t, "testdata/data_regions.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "names.*", "jp-east-1"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "names.*", "jp-west-1"),
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "regions.*", map[string]string{
+						"name": "jp-east-1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegionsDataSource(t *testing.T, fileName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
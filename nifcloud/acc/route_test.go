@@ -0,0 +1,124 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func TestAcc_Route(t *testing.T) {
+	resourceName := "nifcloud_route.basic"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccRouteResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute(t, "testdata/route.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "route_table_id"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "192.168.1.254"),
+				),
+			},
+			{
+				Config: testAccRoute(t, "testdata/route_update.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "route_table_id"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "192.168.1.253"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRoute(t *testing.T, fileName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func testAccCheckRouteExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no route resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no route id is set")
+		}
+
+		found, err := testAccRouteFound(saved)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("route does not found in cloud: %s", saved.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccRouteResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_route" {
+			continue
+		}
+
+		found, err := testAccRouteFound(rs)
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.RouteTableId" {
+				return nil
+			}
+			return fmt.Errorf("failed DescribeRouteTablesRequest: %s", err)
+		}
+
+		if found {
+			return fmt.Errorf("route (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccRouteFound(rs *terraform.ResourceState) (bool, error) {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	res, err := svc.DescribeRouteTables(context.Background(), &computing.DescribeRouteTablesInput{
+		RouteTableId: []string{rs.Primary.Attributes["route_table_id"]},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, rt := range res.RouteTableSet {
+		for _, r := range rt.RouteSet {
+			if nifcloud.ToString(r.DestinationCidrBlock) == rs.Primary.Attributes["cidr_block"] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
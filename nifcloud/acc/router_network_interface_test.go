@@ -0,0 +1,133 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func TestAcc_RouterNetworkInterface(t *testing.T) {
+	resourceName := "nifcloud_router_network_interface.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccRouterNetworkInterfaceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouterNetworkInterface(t, "testdata/router_network_interface.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouterNetworkInterfaceExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "router_id", "nifcloud_router.basic", "router_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_id", "nifcloud_private_lan.additional", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "192.168.2.1"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp", "false"),
+				),
+			},
+			{
+				Config: testAccRouterNetworkInterface(t, "testdata/router_network_interface_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouterNetworkInterfaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "192.168.2.2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ip_address",
+				},
+			},
+		},
+	})
+}
+
+func testAccRouterNetworkInterface(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckRouterNetworkInterfaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no router network interface resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no router network interface id is set")
+		}
+
+		attached, err := testAccRouterNetworkInterfaceAttached(saved)
+		if err != nil {
+			return err
+		}
+
+		if !attached {
+			return fmt.Errorf("router network interface does not found in cloud: %s", saved.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccRouterNetworkInterfaceResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_router_network_interface" {
+			continue
+		}
+
+		attached, err := testAccRouterNetworkInterfaceAttached(rs)
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.RouterId" {
+				return nil
+			}
+			return fmt.Errorf("failed NiftyDescribeRoutersRequest: %s", err)
+		}
+
+		if attached {
+			return fmt.Errorf("router network interface (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccRouterNetworkInterfaceAttached(rs *terraform.ResourceState) (bool, error) {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	res, err := svc.NiftyDescribeRouters(context.Background(), &computing.NiftyDescribeRoutersInput{
+		RouterId: []string{rs.Primary.Attributes["router_id"]},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range res.RouterSet {
+		for _, ni := range r.NetworkInterfaceSet {
+			if nifcloud.ToString(ni.NetworkId) == rs.Primary.Attributes["network_id"] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -78,6 +78,38 @@ func TestAcc_SecurityGroup(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceSecurityGroup_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_security_group.basic"
+	rulesDatasourceName := "data.nifcloud_security_group_rules.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccSecurityGroupResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityGroup(t, "testdata/data_security_group.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", randName),
+					resource.TestCheckResourceAttr(datasourceName, "group_name", randName),
+					resource.TestCheckResourceAttr(datasourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(datasourceName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(datasourceName, "log_limit", "100000"),
+					resource.TestCheckResourceAttr(datasourceName, "instances.#", "0"),
+					resource.TestCheckResourceAttr(rulesDatasourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(rulesDatasourceName, "rules.0.type", "IN"),
+					resource.TestCheckResourceAttr(rulesDatasourceName, "rules.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr(rulesDatasourceName, "rules.0.from_port", "22"),
+					resource.TestCheckResourceAttr(rulesDatasourceName, "rules.0.to_port", "22"),
+					resource.TestCheckResourceAttr(rulesDatasourceName, "rules.0.cidr_ip", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr(rulesDatasourceName, "rules.0.description", "memo"),
+				),
+			},
+		},
+	})
+}
+
 func testAccSecurityGroup(t *testing.T, fileName, groupName string) string {
 	b, err := ioutil.ReadFile(fileName)
 	if err != nil {
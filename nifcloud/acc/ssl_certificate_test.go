@@ -78,6 +78,37 @@ func TestAcc_SSLCertificate(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceSSLCertificate_basic(t *testing.T) {
+	resourceName := "nifcloud_ssl_certificate.basic"
+	randName := prefix + acctest.RandString(10)
+
+	caKey := helper.GeneratePrivateKey(t, 4096)
+	caCert := helper.GenerateSelfSignedCertificateAuthority(t, caKey)
+	key := helper.GeneratePrivateKey(t, 4096)
+	cert := helper.GenerateCertificate(t, caKey, caCert, key, randName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccSSLCertificateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSLCertificate(t, "testdata/data_ssl_certificate.tf", cert, key, caCert),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.nifcloud_ssl_certificate.by_fqdn", "fqdn_id", resourceName, "fqdn_id"),
+					resource.TestCheckResourceAttr("data.nifcloud_ssl_certificate.by_fqdn", "fqdn", randName),
+					resource.TestCheckResourceAttr("data.nifcloud_ssl_certificate.by_fqdn", "description", "memo"),
+					resource.TestCheckResourceAttr("data.nifcloud_ssl_certificate.by_fqdn", "key_length", "4096"),
+					resource.TestCheckResourceAttrSet("data.nifcloud_ssl_certificate.by_fqdn", "start_date"),
+					resource.TestCheckResourceAttrSet("data.nifcloud_ssl_certificate.by_fqdn", "end_date"),
+					resource.TestCheckResourceAttrPair("data.nifcloud_ssl_certificate.by_fqdn_id", "fqdn_id", resourceName, "fqdn_id"),
+					resource.TestCheckResourceAttr("data.nifcloud_ssl_certificate.by_fqdn_id", "fqdn", randName),
+				),
+			},
+		},
+	})
+}
+
 func testAccSSLCertificate(t *testing.T, fileName, certificate, key, ca string) string {
 	b, err := ioutil.ReadFile(fileName)
 	if err != nil {
@@ -68,6 +68,78 @@ func TestAcc_StorageBucket(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceStorageBucket_basic(t *testing.T) {
+	bucketDatasourceName := "data.nifcloud_storage_bucket.basic"
+	objectDatasourceName := "data.nifcloud_storage_object.basic"
+	objectsDatasourceName := "data.nifcloud_storage_objects.basic"
+	randName := prefix + acctest.RandString(7)
+	objectKey := "scripts/bootstrap.sh"
+	objectBody := "#!/bin/sh\necho hello\n"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccStorageBucketResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket(t, "testdata/data_storage_bucket.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(bucketDatasourceName, "bucket", randName),
+					resource.TestCheckResourceAttr(bucketDatasourceName, "versioning.#", "1"),
+					resource.TestCheckResourceAttr(bucketDatasourceName, "versioning.0.enabled", "false"),
+					resource.TestCheckResourceAttrSet(bucketDatasourceName, "creation_date"),
+					resource.TestCheckResourceAttr(objectsDatasourceName, "keys.#", "0"),
+				),
+			},
+			{
+				PreConfig: testAccPutStorageObject(t, randName, objectKey, objectBody),
+				Config:    testAccStorageBucket(t, "testdata/data_storage_object.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(objectDatasourceName, "body", objectBody),
+					resource.TestCheckResourceAttr(objectDatasourceName, "content_type", "text/x-shellscript"),
+					resource.TestCheckResourceAttrSet(objectDatasourceName, "etag"),
+					resource.TestCheckResourceAttr(objectsDatasourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(objectsDatasourceName, "keys.0", objectKey),
+					resource.TestCheckResourceAttr(objectsDatasourceName, "objects.0.size", fmt.Sprint(len(objectBody))),
+				),
+			},
+			{
+				PreConfig: testAccDeleteStorageObject(t, randName, objectKey),
+				Config:    testAccStorageBucket(t, "testdata/data_storage_bucket.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(objectsDatasourceName, "keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPutStorageObject(t *testing.T, bucket, key, body string) func() {
+	return func() {
+		svc := testAccProvider.Meta().(*client.Client).Storage
+		if _, err := svc.PutObject(context.Background(), &storage.PutObjectInput{
+			Bucket:      nifcloud.String(bucket),
+			Object:      nifcloud.String(key),
+			Body:        []byte(body),
+			ContentType: nifcloud.String("text/x-shellscript"),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccDeleteStorageObject(t *testing.T, bucket, key string) func() {
+	return func() {
+		svc := testAccProvider.Meta().(*client.Client).Storage
+		if _, err := svc.DeleteObject(context.Background(), &storage.DeleteObjectInput{
+			Bucket: nifcloud.String(bucket),
+			Object: nifcloud.String(key),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
 func testAccStorageBucket(t *testing.T, fileName, rName string) string {
 	b, err := ioutil.ReadFile(fileName)
 	if err != nil {
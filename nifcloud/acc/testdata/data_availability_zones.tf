@@ -0,0 +1,7 @@
+provider "nifcloud" {
+  region = "jp-east-1"
+}
+
+data "nifcloud_availability_zones" "basic" {
+  state = "available"
+}
@@ -0,0 +1,83 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_elb_instance_health" "basic" {
+  elb_id        = nifcloud_elb_instance_attachment.basic.elb_id
+  protocol      = nifcloud_elb_instance_attachment.basic.protocol
+  lb_port       = nifcloud_elb_instance_attachment.basic.lb_port
+  instance_port = nifcloud_elb_instance_attachment.basic.instance_port
+}
+
+resource "nifcloud_elb_instance_attachment" "basic" {
+  elb_id           = nifcloud_elb.basic.elb_id
+  protocol         = nifcloud_elb.basic.protocol
+  lb_port          = nifcloud_elb.basic.lb_port
+  instance_port    = nifcloud_elb.basic.instance_port
+  instance_id      = nifcloud_instance.basic.instance_id
+  wait_for_healthy = true
+}
+
+resource "nifcloud_elb" "basic" {
+  elb_name          = "%s"
+  availability_zone = "east-21"
+  instance_port     = 80
+  protocol          = "HTTP"
+  lb_port           = 80
+
+  network_interface {
+    network_name   = nifcloud_private_lan.basic.private_lan_name
+    ip_address     = "192.168.100.101"
+    is_vip_network = false
+  }
+
+  network_interface {
+    network_id     = "net-COMMON_GLOBAL"
+    is_vip_network = true
+  }
+
+  lifecycle {
+    ignore_changes = [instances]
+  }
+}
+
+resource "nifcloud_instance" "basic" {
+  instance_id       = "%s"
+  availability_zone = "east-21"
+  image_id          = "221"
+  key_name          = nifcloud_key_pair.basic.key_name
+  user_data         = <<EOT
+#!/bin/bash
+
+cat << EOS > /etc/netplan/99-netcfg.yaml
+network:
+  version: 2
+  renderer: networkd
+  ethernets:
+      ens224:
+          dhcp4: false
+          addresses: [192.168.100.100/24]
+          dhcp6: false
+EOS
+netplan apply
+  EOT
+
+  network_interface {
+    network_name = nifcloud_private_lan.basic.private_lan_name
+    ip_address   = "static"
+  }
+
+  network_interface {
+    network_id = "net-COMMON_GLOBAL"
+  }
+}
+
+resource "nifcloud_key_pair" "basic" {
+  key_name   = "%s"
+  public_key = "c3NoLXJzYSBBQUFBQjNOemFDMXljMkVBQUFBREFRQUJBQUFCQVFEeFVVSmtIWFFvalVmeGphT3dQNVJmMjhOTVRFSjJFblBQdFk0b1NkZFBpRllnMWVDTGFNU08wV25nZVIrVk5sU215am1qU2xRWjBsc1BkcHZjWnY0KzZiMDlLUUZlT3NxakdjNE9Ga1o2MTZyTEI3UmdzblZnSXl3QmtIZ2lsMVQzbFRwRHVtYVk2TFFaRjRiaVpTNkNyaFdYeVhiSjFUVmYyZ0hIYXZPdi9WSS9ITjhIejlnSDg5Q0xWRVFOWFVQbXdjbC83ZE4yMXE4QnhNVkpGNW1sSW1RcGxwTjFKQVRwdnBXSXVXSzZZOFpYblEvYVowMDBMTFVBMVA4N1l3V2FRSWJRTGVPelNhc29GYm5pbkZ3R05FdVdCK0t5MWNMQkRZc1lmZExHQnVYTkRlVmtnUUE3ODJXWWxaNU1lN0RVMWt0Q0U3Qk5jOUlyUVA1YWZDU2g="
+}
+
+resource "nifcloud_private_lan" "basic" {
+  private_lan_name = "%s"
+  cidr_block       = "192.168.100.0/24"
+}
@@ -0,0 +1,29 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_hatoba_cluster" "basic" {
+  name                = nifcloud_hatoba_cluster.basic.name
+  include_kube_config = true
+}
+
+resource "nifcloud_hatoba_cluster" "basic" {
+  name           = "%s"
+  description    = "memo"
+  firewall_group = nifcloud_hatoba_firewall_group.basic.name
+  locations      = ["east-21"]
+
+  network_config {
+    network_id = "net-COMMON_PRIVATE"
+  }
+
+  node_pools {
+    name          = "default"
+    instance_type = "medium"
+    node_count    = 1
+  }
+}
+
+resource "nifcloud_hatoba_firewall_group" "basic" {
+  name = "%s"
+}
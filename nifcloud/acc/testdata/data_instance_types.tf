@@ -0,0 +1,3 @@
+data "nifcloud_instance_types" "basic" {
+  name_regex = "^e-"
+}
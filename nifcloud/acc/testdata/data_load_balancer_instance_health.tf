@@ -0,0 +1,47 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_load_balancer_instance_health" "basic" {
+  load_balancer_name = nifcloud_load_balancer_instance_attachment.basic.load_balancer_name
+  load_balancer_port = nifcloud_load_balancer_instance_attachment.basic.load_balancer_port
+  instance_port      = nifcloud_load_balancer_instance_attachment.basic.instance_port
+}
+
+resource "nifcloud_load_balancer_instance_attachment" "basic" {
+  load_balancer_name = nifcloud_load_balancer.basic.load_balancer_name
+  load_balancer_port = nifcloud_load_balancer.basic.load_balancer_port
+  instance_port      = nifcloud_load_balancer.basic.instance_port
+  instance_id        = nifcloud_instance.basic.instance_id
+  wait_for_healthy   = true
+}
+
+resource "nifcloud_load_balancer" "basic" {
+  load_balancer_name = "%s"
+  instance_port      = 80
+  load_balancer_port = 80
+
+  lifecycle {
+    ignore_changes = [instances]
+  }
+}
+
+resource "nifcloud_instance" "basic" {
+  instance_id       = "%s"
+  availability_zone = "east-21"
+  image_id          = "221"
+  key_name          = nifcloud_key_pair.basic.key_name
+
+  network_interface {
+    network_id = "net-COMMON_GLOBAL"
+  }
+
+  network_interface {
+    network_id = "net-COMMON_PRIVATE"
+  }
+}
+
+resource "nifcloud_key_pair" "basic" {
+  key_name   = "%s"
+  public_key = "c3NoLXJzYSBBQUFBQjNOemFDMXljMkVBQUFBREFRQUJBQUFCQVFEeFVVSmtIWFFvalVmeGphT3dQNVJmMjhOTVRFSjJFblBQdFk0b1NkZFBpRllnMWVDTGFNU08wV25nZVIrVk5sU215am1qU2xRWjBsc1BkcHZjWnY0KzZiMDlLUUZlT3NxakdjNE9Ga1o2MTZyTEI3UmdzblZnSXl3QmtIZ2lsMVQzbFRwRHVtYVk2TFFaRjRiaVpTNkNyaFdYeVhiSjFUVmYyZ0hIYXZPdi9WSS9ITjhIejlnSDg5Q0xWRVFOWFVQbXdjbC83ZE4yMXE4QnhNVkpGNW1sSW1RcGxwTjFKQVRwdnBXSXVXSzZZOFpYblEvYVowMDBMTFVBMVA4N1l3V2FRSWJRTGVPelNhc29GYm5pbkZ3R05FdVdCK0t5MWNMQkRZc1lmZExHQnVYTkRlVmtnUUE3ODJXWWxaNU1lN0RVMWt0Q0U3Qk5jOUlyUVA1YWZDU2g="
+}
@@ -0,0 +1,3 @@
+data "nifcloud_load_balancer_ssl_policies" "basic" {
+  name_regex = "^Standard"
+}
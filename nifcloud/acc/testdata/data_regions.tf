@@ -0,0 +1 @@
+data "nifcloud_regions" "basic" {}
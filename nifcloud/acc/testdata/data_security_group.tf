@@ -0,0 +1,34 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_security_group" "basic" {
+  group_name = nifcloud_security_group.basic.group_name
+
+  depends_on = [nifcloud_security_group_rule.basic]
+}
+
+data "nifcloud_security_group_rules" "basic" {
+  group_name = nifcloud_security_group.basic.group_name
+  type       = "IN"
+
+  depends_on = [nifcloud_security_group_rule.basic]
+}
+
+resource "nifcloud_security_group_rule" "basic" {
+  security_group_names = [nifcloud_security_group.basic.group_name]
+  type                 = "IN"
+  from_port            = 22
+  to_port              = 22
+  protocol             = "TCP"
+  cidr_ip              = "192.0.2.0/24"
+  description          = "memo"
+}
+
+resource "nifcloud_security_group" "basic" {
+  group_name             = "%s"
+  description            = "memo"
+  availability_zone      = "east-21"
+  log_limit              = 100000
+  revoke_rules_on_delete = true
+}
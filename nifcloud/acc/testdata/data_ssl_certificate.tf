@@ -0,0 +1,17 @@
+data "nifcloud_ssl_certificate" "by_fqdn" {
+  fqdn = nifcloud_ssl_certificate.basic.fqdn
+}
+
+data "nifcloud_ssl_certificate" "by_fqdn_id" {
+  fqdn_id = nifcloud_ssl_certificate.basic.fqdn_id
+}
+
+resource "nifcloud_ssl_certificate" "basic" {
+  certificate = <<EOT
+%sEOT
+  key         = <<EOT
+%sEOT
+  ca          = <<EOT
+%sEOT
+  description = "memo"
+}
@@ -0,0 +1,16 @@
+provider "nifcloud" {
+  storage_region = "jp-east-1"
+}
+
+data "nifcloud_storage_bucket" "basic" {
+  bucket = nifcloud_storage_bucket.basic.bucket
+}
+
+data "nifcloud_storage_objects" "basic" {
+  bucket = nifcloud_storage_bucket.basic.bucket
+  prefix = "scripts/"
+}
+
+resource "nifcloud_storage_bucket" "basic" {
+  bucket = "%s"
+}
@@ -0,0 +1,17 @@
+provider "nifcloud" {
+  storage_region = "jp-east-1"
+}
+
+data "nifcloud_storage_object" "basic" {
+  bucket = nifcloud_storage_bucket.basic.bucket
+  key    = "scripts/bootstrap.sh"
+}
+
+data "nifcloud_storage_objects" "basic" {
+  bucket = nifcloud_storage_bucket.basic.bucket
+  prefix = "scripts/"
+}
+
+resource "nifcloud_storage_bucket" "basic" {
+  bucket = "%s"
+}
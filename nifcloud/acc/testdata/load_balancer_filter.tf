@@ -0,0 +1,21 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+resource "nifcloud_load_balancer_filter" "basic" {
+  load_balancer_name = nifcloud_load_balancer.basic.load_balancer_name
+  load_balancer_port = nifcloud_load_balancer.basic.load_balancer_port
+  instance_port      = nifcloud_load_balancer.basic.instance_port
+  ip_address         = "192.0.2.1"
+}
+
+resource "nifcloud_load_balancer" "basic" {
+  load_balancer_name = "%s"
+  instance_port      = 80
+  load_balancer_port = 80
+  filter_type        = "1"
+
+  lifecycle {
+    ignore_changes = [filter]
+  }
+}
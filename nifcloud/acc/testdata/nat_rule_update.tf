@@ -0,0 +1,37 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+resource "nifcloud_nat_rule" "snat" {
+  nat_table_id                  = nifcloud_nat_table.basic.nat_table_id
+  nat_type                      = "snat"
+  rule_number                   = "1"
+  description                   = "memo-upd"
+  protocol                      = "ALL"
+  source_address                = "192.168.1.10"
+  outbound_interface_network_id = "net-COMMON_GLOBAL"
+}
+
+resource "nifcloud_nat_rule" "dnat" {
+  nat_table_id                   = nifcloud_nat_table.basic.nat_table_id
+  nat_type                       = "dnat"
+  rule_number                    = "1"
+  description                    = "memo-upd"
+  protocol                       = "TCP"
+  destination_port               = 8080
+  translation_address            = "192.168.1.10"
+  translation_port               = 80
+  inbound_interface_network_name = nifcloud_private_lan.basic.private_lan_name
+}
+
+resource "nifcloud_nat_table" "basic" {
+  lifecycle {
+    ignore_changes = [snat, dnat]
+  }
+}
+
+resource "nifcloud_private_lan" "basic" {
+  private_lan_name  = "%s"
+  availability_zone = "east-21"
+  cidr_block        = "192.168.1.0/24"
+}
@@ -0,0 +1,58 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+resource "nifcloud_nat_table_association" "basic" {
+  nat_table_id = nifcloud_nat_table.first.nat_table_id
+  router_id    = nifcloud_router.basic.router_id
+}
+
+resource "nifcloud_nat_table" "first" {
+  snat {
+    rule_number                   = "1"
+    protocol                      = "ALL"
+    source_address                = "192.168.1.10"
+    outbound_interface_network_id = "net-COMMON_GLOBAL"
+  }
+}
+
+resource "nifcloud_nat_table" "second" {
+  snat {
+    rule_number                   = "2"
+    protocol                      = "ALL"
+    source_address                = "192.168.1.10"
+    outbound_interface_network_id = "net-COMMON_GLOBAL"
+  }
+}
+
+resource "nifcloud_router" "basic" {
+  name              = "%s"
+  availability_zone = "east-21"
+  accounting_type   = "2"
+  type              = "small"
+  security_group    = nifcloud_security_group.basic.group_name
+
+  network_interface {
+    network_id = "net-COMMON_GLOBAL"
+  }
+
+  network_interface {
+    network_id = nifcloud_private_lan.basic.id
+    ip_address = "192.168.1.1"
+  }
+
+  lifecycle {
+    ignore_changes = [nat_table_id]
+  }
+}
+
+resource "nifcloud_private_lan" "basic" {
+  private_lan_name  = "%s"
+  availability_zone = "east-21"
+  cidr_block        = "192.168.1.0/24"
+}
+
+resource "nifcloud_security_group" "basic" {
+  group_name        = "%s"
+  availability_zone = "east-21"
+}
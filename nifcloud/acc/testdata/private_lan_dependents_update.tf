@@ -0,0 +1,21 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+resource "nifcloud_private_lan" "basic" {
+  private_lan_name    = "%s"
+  availability_zone   = "east-21"
+  cidr_block          = "192.168.0.0/16"
+  wait_for_dependents = true
+}
+
+resource "nifcloud_router" "basic" {
+  name              = "%s"
+  availability_zone = "east-21"
+  type              = "small"
+
+  network_interface {
+    network_id = nifcloud_private_lan.basic.id
+    ip_address = "192.168.1.1"
+  }
+}
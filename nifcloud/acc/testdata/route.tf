@@ -0,0 +1,15 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+resource "nifcloud_route" "basic" {
+  route_table_id = nifcloud_route_table.basic.route_table_id
+  cidr_block     = "10.0.1.0/24"
+  ip_address     = "192.168.1.254"
+}
+
+resource "nifcloud_route_table" "basic" {
+  lifecycle {
+    ignore_changes = [route]
+  }
+}
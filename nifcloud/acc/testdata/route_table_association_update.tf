@@ -0,0 +1,50 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+resource "nifcloud_route_table_association" "basic" {
+  route_table_id = nifcloud_route_table.second.route_table_id
+  router_id      = nifcloud_router.basic.router_id
+}
+
+resource "nifcloud_route_table" "first" {
+  route {
+    cidr_block = "10.0.1.0/24"
+    ip_address = "192.168.1.254"
+  }
+}
+
+resource "nifcloud_route_table" "second" {
+  route {
+    cidr_block = "10.0.2.0/24"
+    ip_address = "192.168.1.254"
+  }
+}
+
+resource "nifcloud_router" "basic" {
+  name              = "%s"
+  availability_zone = "east-21"
+  accounting_type   = "2"
+  type              = "small"
+  security_group    = nifcloud_security_group.basic.group_name
+
+  network_interface {
+    network_id = nifcloud_private_lan.basic.id
+    ip_address = "192.168.1.1"
+  }
+
+  lifecycle {
+    ignore_changes = [route_table_id]
+  }
+}
+
+resource "nifcloud_private_lan" "basic" {
+  private_lan_name  = "%s"
+  availability_zone = "east-21"
+  cidr_block        = "192.168.1.0/24"
+}
+
+resource "nifcloud_security_group" "basic" {
+  group_name        = "%s"
+  availability_zone = "east-21"
+}
@@ -0,0 +1,44 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+resource "nifcloud_router_network_interface" "basic" {
+  router_id  = nifcloud_router.basic.router_id
+  network_id = nifcloud_private_lan.additional.id
+  ip_address = "192.168.2.1"
+  dhcp       = false
+}
+
+resource "nifcloud_router" "basic" {
+  name              = "%s"
+  availability_zone = "east-21"
+  accounting_type   = "2"
+  type              = "small"
+  security_group    = nifcloud_security_group.basic.group_name
+
+  network_interface {
+    network_id = nifcloud_private_lan.basic.id
+    ip_address = "192.168.1.1"
+  }
+
+  lifecycle {
+    ignore_changes = [network_interface]
+  }
+}
+
+resource "nifcloud_private_lan" "basic" {
+  private_lan_name  = "%s"
+  availability_zone = "east-21"
+  cidr_block        = "192.168.1.0/24"
+}
+
+resource "nifcloud_private_lan" "additional" {
+  private_lan_name  = "%sadd"
+  availability_zone = "east-21"
+  cidr_block        = "192.168.2.0/24"
+}
+
+resource "nifcloud_security_group" "basic" {
+  group_name        = "%s"
+  availability_zone = "east-21"
+}
@@ -0,0 +1,105 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func TestAcc_VolumeAttachment(t *testing.T) {
+	var volume types.VolumeSet
+
+	resourceName := "nifcloud_volume_attachment.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccVolumeAttachmentResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeAttachment(t, "testdata/volume_attachment.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(resourceName, &volume),
+					testAccCheckVolumeAttachmentValues(&volume, randName),
+					resource.TestCheckResourceAttr(resourceName, "volume_id", randName),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", randName),
+					resource.TestCheckResourceAttrSet(resourceName, "device"),
+					resource.TestCheckResourceAttr("nifcloud_volume.basic", "instance_id", randName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_detach",
+					"agreement",
+				},
+			},
+		},
+	})
+}
+
+func testAccVolumeAttachment(t *testing.T, fileName, rName string) string {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckVolumeAttachmentValues(volume *types.VolumeSet, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(volume.AttachmentSet) == 0 {
+			return fmt.Errorf("bad attachment state, expected attached to \"%s\", got: %#v", rName, volume.AttachmentSet)
+		}
+
+		if nifcloud.ToString(volume.AttachmentSet[0].InstanceId) != rName {
+			return fmt.Errorf("bad instance_id state, expected \"%s\", got: %#v", rName, volume.AttachmentSet[0].InstanceId)
+		}
+		return nil
+	}
+}
+
+func testAccVolumeAttachmentResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_volume_attachment" {
+			continue
+		}
+
+		res, err := svc.DescribeVolumes(context.Background(), &computing.DescribeVolumesInput{
+			VolumeId: []string{rs.Primary.ID},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Volume" {
+				return nil
+			}
+			return fmt.Errorf("failed DescribeVolumesRequest: %s", err)
+		}
+
+		if len(res.VolumeSet) > 0 && len(res.VolumeSet[0].AttachmentSet) > 0 {
+			return fmt.Errorf("volume attachment (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -64,6 +64,8 @@ func TestAcc_VpnConnection(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "ipsec_config_encapsulating_security_payload_lifetime", "301"),
 					resource.TestCheckResourceAttr(resourceName, "ipsec_config_diffie_hellman_group", "5"),
 					resource.TestCheckResourceAttr(resourceName, "description", "tfacc-memo"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+					resource.TestCheckResourceAttr(resourceName, "vgw_telemetry.#", "1"),
 				),
 			},
 			{
@@ -75,12 +77,36 @@ func TestAcc_VpnConnection(t *testing.T) {
 					"vpn_gateway_name",
 					"customer_gateway_id",
 					"vpn_gateway_id",
+					"wait_until_up",
 				},
 			},
 		},
 	})
 }
 
+func TestAccDatasourceVpnConnectionDeviceConfig_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_vpn_connection_device_config.basic"
+	resourceName := "nifcloud_vpn_connection.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccVpnConnectionResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnConnection(t, "testdata/data_vpn_connection_device_config.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "vpn_connection_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "vpn_gateway_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "customer_gateway_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "customer_gateway_configuration"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_VpnConnection_Id_No_Tunnel(t *testing.T) {
 	var VpnConnection types.VpnConnectionSet
 
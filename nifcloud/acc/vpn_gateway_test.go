@@ -51,6 +51,7 @@ func TestAcc_VpnGateway(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", randName),
 					resource.TestCheckResourceAttr(resourceName, "type", "small"),
 					resource.TestCheckResourceAttr(resourceName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(resourceName, "redundancy", "false"),
 					resource.TestCheckResourceAttr(resourceName, "accounting_type", "2"),
 					resource.TestCheckResourceAttr(resourceName, "network_name", randName),
 					resource.TestCheckResourceAttr(resourceName, "ip_address", "192.168.3.1"),
@@ -70,6 +71,7 @@ func TestAcc_VpnGateway(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", randName+"upd"),
 					resource.TestCheckResourceAttr(resourceName, "type", "medium"),
 					resource.TestCheckResourceAttr(resourceName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(resourceName, "redundancy", "false"),
 					resource.TestCheckResourceAttr(resourceName, "accounting_type", "1"),
 					resource.TestCheckResourceAttr(resourceName, "network_name", randName),
 					resource.TestCheckResourceAttr(resourceName, "ip_address", "192.168.3.2"),
@@ -0,0 +1,43 @@
+package availabilityzones
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeAvailabilityZonesOutput) error {
+	state := d.Get("state").(string)
+
+	names := []string{}
+	zones := []map[string]interface{}{}
+	for _, z := range res.AvailabilityZoneInfo {
+		if state != "" && nifcloud.ToString(z.ZoneState) != state {
+			continue
+		}
+
+		names = append(names, nifcloud.ToString(z.ZoneName))
+		zones = append(zones, map[string]interface{}{
+			"name":                     nifcloud.ToString(z.ZoneName),
+			"region_name":              nifcloud.ToString(z.RegionName),
+			"state":                    nifcloud.ToString(z.ZoneState),
+			"is_default":               nifcloud.ToBool(z.IsDefault),
+			"security_group_supported": nifcloud.ToBool(z.SecurityGroupSupported),
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	if err := d.Set("zones", zones); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package availabilityzones
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	res := &computing.DescribeAvailabilityZonesOutput{
+		AvailabilityZoneInfo: []types.AvailabilityZoneInfo{
+			{
+				ZoneName:               nifcloud.String("east-11"),
+				RegionName:             nifcloud.String("jp-east-1"),
+				ZoneState:              nifcloud.String("available"),
+				IsDefault:              nifcloud.Bool(true),
+				SecurityGroupSupported: nifcloud.Bool(true),
+			},
+			{
+				ZoneName:               nifcloud.String("east-12"),
+				RegionName:             nifcloud.String("jp-east-1"),
+				ZoneState:              nifcloud.String("unavailable"),
+				IsDefault:              nifcloud.Bool(false),
+				SecurityGroupSupported: nifcloud.Bool(true),
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		raw       map[string]interface{}
+		wantNames []interface{}
+		wantZones []interface{}
+	}{
+		{
+			name:      "flattens all zones",
+			raw:       map[string]interface{}{},
+			wantNames: []interface{}{"east-11", "east-12"},
+			wantZones: []interface{}{
+				map[string]interface{}{
+					"name":                     "east-11",
+					"region_name":              "jp-east-1",
+					"state":                    "available",
+					"is_default":               true,
+					"security_group_supported": true,
+				},
+				map[string]interface{}{
+					"name":                     "east-12",
+					"region_name":              "jp-east-1",
+					"state":                    "unavailable",
+					"is_default":               false,
+					"security_group_supported": true,
+				},
+			},
+		},
+		{
+			name: "flattens only zones matching the state",
+			raw: map[string]interface{}{
+				"state": "available",
+			},
+			wantNames: []interface{}{"east-11"},
+			wantZones: []interface{}{
+				map[string]interface{}{
+					"name":                     "east-11",
+					"region_name":              "jp-east-1",
+					"state":                    "available",
+					"is_default":               true,
+					"security_group_supported": true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, newSchema(), tt.raw)
+			err := flatten(d, res)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, d.Id())
+			assert.Equal(t, tt.wantNames, d.Get("names"))
+			assert.Equal(t, tt.wantZones, d.Get("zones"))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package availabilityzones
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.DescribeAvailabilityZones(ctx, &computing.DescribeAvailabilityZonesInput{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading availability zones: %s", err))
+	}
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
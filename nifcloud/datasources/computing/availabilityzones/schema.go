@@ -0,0 +1,75 @@
+package availabilityzones
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get the list of availability zones in the current region."
+
+// New returns the nifcloud_availability_zones data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"state": {
+			Type:        schema.TypeString,
+			Description: "Filter the zones by their state (e.g. `available`).",
+			Optional:    true,
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Description: "The list of availability zone names.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"zones": {
+			Type:        schema.TypeList,
+			Description: "The list of availability zones.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the availability zone.",
+						Computed:    true,
+					},
+					"region_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the region the availability zone belongs to.",
+						Computed:    true,
+					},
+					"state": {
+						Type:        schema.TypeString,
+						Description: "The state of the availability zone.",
+						Computed:    true,
+					},
+					"is_default": {
+						Type:        schema.TypeBool,
+						Description: "Whether the availability zone is the default zone of the region.",
+						Computed:    true,
+					},
+					"security_group_supported": {
+						Type:        schema.TypeBool,
+						Description: "Whether the availability zone supports security groups.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package instancesnapshots
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.NiftyDescribeInstanceSnapshotsOutput) error {
+	instanceID := d.Get("instance_id").(string)
+
+	ids := []string{}
+	snapshots := []map[string]interface{}{}
+	for _, s := range res.SnapshotInfoSet {
+		if nifcloud.ToString(s.InstanceId) != instanceID {
+			continue
+		}
+
+		ids = append(ids, nifcloud.ToString(s.InstanceSnapshotId))
+		snapshots = append(snapshots, map[string]interface{}{
+			"instance_snapshot_id": nifcloud.ToString(s.InstanceSnapshotId),
+			"snapshot_name":        nifcloud.ToString(s.SnapshotName),
+			"description":          nifcloud.ToString(s.Memo),
+			"status":               nifcloud.ToString(s.Status),
+			"power_status":         nifcloud.ToString(s.PowerStatus),
+			"created_time":         nifcloud.ToString(s.CreatedTime),
+			"expired_time":         nifcloud.ToString(s.ExpiredTime),
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(instanceID + ":" + strings.Join(ids, ","))))
+
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	if err := d.Set("snapshots", snapshots); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package instancesnapshots
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	res := &computing.NiftyDescribeInstanceSnapshotsOutput{
+		SnapshotInfoSet: []types.SnapshotInfoSet{
+			{
+				InstanceSnapshotId: nifcloud.String("test_instance_snapshot_id"),
+				InstanceId:         nifcloud.String("test_instance_id"),
+				SnapshotName:       nifcloud.String("test_snapshot_name"),
+				Memo:               nifcloud.String("test_description"),
+				Status:             nifcloud.String("normal"),
+				PowerStatus:        nifcloud.String("running"),
+				CreatedTime:        nifcloud.String("test_created_time"),
+				ExpiredTime:        nifcloud.String("test_expired_time"),
+			},
+			{
+				InstanceSnapshotId: nifcloud.String("test_other_instance_snapshot_id"),
+				InstanceId:         nifcloud.String("test_other_instance_id"),
+				SnapshotName:       nifcloud.String("test_other_snapshot_name"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		raw           map[string]interface{}
+		wantIDs       []interface{}
+		wantSnapshots []interface{}
+	}{
+		{
+			name: "flattens only snapshots of the instance",
+			raw: map[string]interface{}{
+				"instance_id": "test_instance_id",
+			},
+			wantIDs: []interface{}{"test_instance_snapshot_id"},
+			wantSnapshots: []interface{}{
+				map[string]interface{}{
+					"instance_snapshot_id": "test_instance_snapshot_id",
+					"snapshot_name":        "test_snapshot_name",
+					"description":          "test_description",
+					"status":               "normal",
+					"power_status":         "running",
+					"created_time":         "test_created_time",
+					"expired_time":         "test_expired_time",
+				},
+			},
+		},
+		{
+			name: "flattens no snapshots when the instance has none",
+			raw: map[string]interface{}{
+				"instance_id": "test_no_snapshot_instance_id",
+			},
+			wantIDs:       []interface{}{},
+			wantSnapshots: []interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, newSchema(), tt.raw)
+			err := flatten(d, res)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, d.Id())
+			assert.Equal(t, tt.wantIDs, d.Get("ids"))
+			assert.Equal(t, tt.wantSnapshots, d.Get("snapshots"))
+		})
+	}
+}
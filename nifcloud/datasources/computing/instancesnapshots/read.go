@@ -0,0 +1,34 @@
+package instancesnapshots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.NiftyDescribeInstanceSnapshots(ctx, &computing.NiftyDescribeInstanceSnapshotsInput{})
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Snapshot" {
+			// There are no snapshots in the region.
+			res = &computing.NiftyDescribeInstanceSnapshotsOutput{}
+		} else {
+			return diag.FromErr(fmt.Errorf("failed reading instance snapshots: %s", err))
+		}
+	}
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package instancesnapshots
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get the list of snapshots of an instance."
+
+// New returns the nifcloud_instance_snapshots data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The instance name.",
+			Required:    true,
+		},
+		"ids": {
+			Type:        schema.TypeList,
+			Description: "The list of instance snapshot IDs.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"snapshots": {
+			Type:        schema.TypeList,
+			Description: "The list of instance snapshots.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"instance_snapshot_id": {
+						Type:        schema.TypeString,
+						Description: "The instance snapshot ID.",
+						Computed:    true,
+					},
+					"snapshot_name": {
+						Type:        schema.TypeString,
+						Description: "The snapshot name.",
+						Computed:    true,
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Description: "The snapshot description.",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "The status of the snapshot.",
+						Computed:    true,
+					},
+					"power_status": {
+						Type:        schema.TypeString,
+						Description: "The power status of the instance when the snapshot was taken.",
+						Computed:    true,
+					},
+					"created_time": {
+						Type:        schema.TypeString,
+						Description: "The time when the snapshot was created.",
+						Computed:    true,
+					},
+					"expired_time": {
+						Type:        schema.TypeString,
+						Description: "The time when the snapshot expires.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package instancetypes
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, values []types.InstanceTypeOfRunInstancesRequest) error {
+	var r *regexp.Regexp
+	if nameRegex := d.Get("name_regex").(string); nameRegex != "" {
+		r = regexp.MustCompile(nameRegex)
+	}
+
+	instanceTypes := []string{}
+	for _, v := range values {
+		if r != nil && !r.MatchString(string(v)) {
+			continue
+		}
+		instanceTypes = append(instanceTypes, string(v))
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(instanceTypes, ","))))
+
+	return d.Set("instance_types", instanceTypes)
+}
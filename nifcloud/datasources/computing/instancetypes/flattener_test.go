@@ -0,0 +1,54 @@
+package instancetypes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	values := []types.InstanceTypeOfRunInstancesRequest{
+		"mini",
+		"e-mini",
+		"e-large",
+		"large",
+	}
+
+	tests := []struct {
+		name string
+		raw  map[string]interface{}
+		want []interface{}
+	}{
+		{
+			name: "flattens all instance types without name_regex",
+			raw:  map[string]interface{}{},
+			want: []interface{}{"mini", "e-mini", "e-large", "large"},
+		},
+		{
+			name: "flattens instance types matching name_regex",
+			raw: map[string]interface{}{
+				"name_regex": "^e-",
+			},
+			want: []interface{}{"e-mini", "e-large"},
+		},
+		{
+			name: "flattens empty list when nothing matches",
+			raw: map[string]interface{}{
+				"name_regex": "^x-",
+			},
+			want: []interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, newSchema(), tt.raw)
+			err := flatten(d, values)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, d.Id())
+			assert.Equal(t, tt.want, d.Get("instance_types"))
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package instancetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// NIFCLOUD does not provide an API to list the instance types,
+	// so the list is built from the values accepted by RunInstances.
+	if err := flatten(d, types.InstanceTypeOfRunInstancesRequest("").Values()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
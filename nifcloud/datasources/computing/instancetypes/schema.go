@@ -0,0 +1,43 @@
+package instancetypes
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the list of instance types available for nifcloud_instance resources."
+
+// New returns the nifcloud_instance_types data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name_regex": {
+			Type:         schema.TypeString,
+			Description:  "A regex string to filter the instance types by name (e.g. `^e-`).",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"instance_types": {
+			Type:        schema.TypeList,
+			Description: "The list of instance type names.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
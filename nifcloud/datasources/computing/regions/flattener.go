@@ -0,0 +1,35 @@
+package regions
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeRegionsOutput) error {
+	names := make([]string, len(res.RegionInfo))
+	regions := make([]map[string]interface{}, len(res.RegionInfo))
+	for i, r := range res.RegionInfo {
+		names[i] = nifcloud.ToString(r.RegionName)
+		regions[i] = map[string]interface{}{
+			"name":       nifcloud.ToString(r.RegionName),
+			"endpoint":   nifcloud.ToString(r.RegionEndpoint),
+			"is_default": nifcloud.ToBool(r.IsDefault),
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	if err := d.Set("regions", regions); err != nil {
+		return err
+	}
+
+	return nil
+}
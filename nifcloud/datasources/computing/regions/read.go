@@ -0,0 +1,26 @@
+package regions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.DescribeRegions(ctx, &computing.DescribeRegionsInput{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading regions: %s", err))
+	}
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package regions
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get the list of NIFCLOUD regions."
+
+// New returns the nifcloud_regions data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"names": {
+			Type:        schema.TypeList,
+			Description: "The list of region names.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"regions": {
+			Type:        schema.TypeList,
+			Description: "The list of regions.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the region.",
+						Computed:    true,
+					},
+					"endpoint": {
+						Type:        schema.TypeString,
+						Description: "The API endpoint of the region.",
+						Computed:    true,
+					},
+					"is_default": {
+						Type:        schema.TypeBool,
+						Description: "Whether the region is the default region.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package securitygroup
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeSecurityGroupsOutput) error {
+	securityGroup := res.SecurityGroupInfo[0]
+
+	if err := d.Set("status", securityGroup.GroupStatus); err != nil {
+		return err
+	}
+
+	instances := make([]string, len(securityGroup.InstancesSet))
+	for i, instance := range securityGroup.InstancesSet {
+		instances[i] = nifcloud.ToString(instance.InstanceId)
+	}
+
+	if err := d.Set("instances", instances); err != nil {
+		return err
+	}
+
+	return nil
+}
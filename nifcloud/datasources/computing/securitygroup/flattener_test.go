@@ -0,0 +1,35 @@
+package securitygroup
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"group_name": "test_group_name",
+	})
+	d.SetId("test_group_name")
+
+	err := flatten(d, &computing.DescribeSecurityGroupsOutput{
+		SecurityGroupInfo: []types.SecurityGroupInfo{
+			{
+				GroupName:   nifcloud.String("test_group_name"),
+				GroupStatus: nifcloud.String("applied"),
+				InstancesSet: []types.InstancesSetOfDescribeSecurityGroups{
+					{InstanceId: nifcloud.String("test_instance_id_1")},
+					{InstanceId: nifcloud.String("test_instance_id_2")},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "applied", d.Get("status"))
+	assert.Equal(t, []interface{}{"test_instance_id_1", "test_instance_id_2"}, d.Get("instances"))
+}
@@ -0,0 +1,61 @@
+package securitygroup
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about a security group."
+
+// New returns the nifcloud_security_group data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"group_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the security group.",
+			Required:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The security group description.",
+			Computed:    true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone.",
+			Computed:    true,
+		},
+		"log_limit": {
+			Type:        schema.TypeInt,
+			Description: "The number of log data for security group.",
+			Computed:    true,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the security group.",
+			Computed:    true,
+		},
+		"instances": {
+			Type:        schema.TypeList,
+			Description: "The list of instance IDs the security group is applied to.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
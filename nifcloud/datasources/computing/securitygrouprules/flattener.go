@@ -0,0 +1,21 @@
+package securitygrouprules
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/securitygrouprule"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeSecurityGroupsOutput) error {
+	ruleType := d.Get("type").(string)
+
+	rules := []map[string]interface{}{}
+	for _, r := range securitygrouprule.FlattenRules(res.SecurityGroupInfo[0].IpPermissions) {
+		if ruleType != "" && r["type"] != ruleType {
+			continue
+		}
+		rules = append(rules, r)
+	}
+
+	return d.Set("rules", rules)
+}
@@ -0,0 +1,93 @@
+package securitygrouprules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	res := &computing.DescribeSecurityGroupsOutput{
+		SecurityGroupInfo: []types.SecurityGroupInfo{
+			{
+				GroupName: nifcloud.String("test_group_name"),
+				IpPermissions: []types.IpPermissions{
+					{
+						InOut:       nifcloud.String("IN"),
+						IpProtocol:  nifcloud.String("TCP"),
+						FromPort:    nifcloud.Int32(80),
+						ToPort:      nifcloud.Int32(443),
+						Description: nifcloud.String("test_description"),
+						IpRanges: []types.IpRanges{
+							{CidrIp: nifcloud.String("192.0.2.0/24")},
+							{CidrIp: nifcloud.String("198.51.100.0/24")},
+						},
+					},
+					{
+						InOut:      nifcloud.String("OUT"),
+						IpProtocol: nifcloud.String("ANY"),
+						Groups: []types.Groups{
+							{GroupName: nifcloud.String("test_source_group")},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	inRule := func(cidr string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":                       "IN",
+			"protocol":                   "TCP",
+			"from_port":                  80,
+			"to_port":                    443,
+			"cidr_ip":                    cidr,
+			"source_security_group_name": "",
+			"description":                "test_description",
+		}
+	}
+	outRule := map[string]interface{}{
+		"type":                       "OUT",
+		"protocol":                   "ANY",
+		"from_port":                  0,
+		"to_port":                    0,
+		"cidr_ip":                    "",
+		"source_security_group_name": "test_source_group",
+		"description":                "",
+	}
+
+	tests := []struct {
+		name string
+		raw  map[string]interface{}
+		want []interface{}
+	}{
+		{
+			name: "flattens all rules",
+			raw: map[string]interface{}{
+				"group_name": "test_group_name",
+			},
+			want: []interface{}{inRule("192.0.2.0/24"), inRule("198.51.100.0/24"), outRule},
+		},
+		{
+			name: "flattens only rules matching the type",
+			raw: map[string]interface{}{
+				"group_name": "test_group_name",
+				"type":       "OUT",
+			},
+			want: []interface{}{outRule},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, newSchema(), tt.raw)
+			err := flatten(d, res)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, d.Get("rules"))
+		})
+	}
+}
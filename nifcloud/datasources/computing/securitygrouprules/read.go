@@ -0,0 +1,41 @@
+package securitygrouprules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+	groupName := d.Get("group_name").(string)
+
+	res, err := svc.DescribeSecurityGroups(ctx, &computing.DescribeSecurityGroupsInput{
+		GroupName: []string{groupName},
+	})
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.SecurityGroup" {
+			return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+		}
+		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+	}
+
+	if len(res.SecurityGroupInfo) < 1 {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	d.SetId(groupName)
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
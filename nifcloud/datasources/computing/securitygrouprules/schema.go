@@ -0,0 +1,84 @@
+package securitygrouprules
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the list of rules of a security group."
+
+// New returns the nifcloud_security_group_rules data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"group_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the security group.",
+			Required:    true,
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Description:  "Filter the rules by type. Valid options are IN (Incoming) or OUT (Outgoing).",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"IN", "OUT"}, false),
+		},
+		"rules": {
+			Type:        schema.TypeList,
+			Description: "The list of security group rules.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:        schema.TypeString,
+						Description: "The type of rule. IN (Incoming) or OUT (Outgoing).",
+						Computed:    true,
+					},
+					"protocol": {
+						Type:        schema.TypeString,
+						Description: "The protocol.",
+						Computed:    true,
+					},
+					"from_port": {
+						Type:        schema.TypeInt,
+						Description: "The start port.",
+						Computed:    true,
+					},
+					"to_port": {
+						Type:        schema.TypeInt,
+						Description: "The end port.",
+						Computed:    true,
+					},
+					"cidr_ip": {
+						Type:        schema.TypeString,
+						Description: "The CIDR IP Address.",
+						Computed:    true,
+					},
+					"source_security_group_name": {
+						Type:        schema.TypeString,
+						Description: "The security group name that allow access.",
+						Computed:    true,
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Description: "The security group rule description.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/hatoba"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	clusterresource "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/hatoba/cluster"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Hatoba
+	name := d.Get("name").(string)
+
+	res, err := svc.GetCluster(ctx, &hatoba.GetClusterInput{
+		ClusterName: nifcloud.String(name),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading Hatoba cluster: %s", err))
+	}
+
+	d.SetId(name)
+
+	if err := clusterresource.Flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !d.Get("include_kube_config").(bool) {
+		if err := d.Set("kube_config_raw", ""); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	getClusterCredentialsRes, err := svc.GetClusterCredentials(ctx, &hatoba.GetClusterCredentialsInput{
+		ClusterName: nifcloud.String(name),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading Hatoba cluster credentials: %s", err))
+	}
+
+	if err := clusterresource.FlattenCredentials(d, getClusterCredentialsRes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about a Kubernetes Service Hatoba cluster."
+
+// New returns the nifcloud_hatoba_cluster data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the cluster.",
+			Required:    true,
+		},
+		"include_kube_config": {
+			Type:        schema.TypeBool,
+			Description: "Whether to fetch the raw Kubernetes config into `kube_config_raw`.",
+			Optional:    true,
+			Default:     false,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The cluster description.",
+			Computed:    true,
+		},
+		"kubernetes_version": {
+			Type:        schema.TypeString,
+			Description: "The version of Kubernetes.",
+			Computed:    true,
+		},
+		"kube_config_raw": {
+			Type:        schema.TypeString,
+			Description: "The raw Kubernetes config to be used by kubectl and other compatible tools. It is set only when `include_kube_config` is true.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"locations": {
+			Type:        schema.TypeList,
+			Description: "The cluster location.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"firewall_group": {
+			Type:        schema.TypeString,
+			Description: "The firewall group name associated with the cluster.",
+			Computed:    true,
+		},
+		"addons_config": {
+			Type:        schema.TypeList,
+			Description: "The configs for Kubernetes addons.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"http_load_balancing": {
+						Type:        schema.TypeList,
+						Description: "The configs for HTTP load balancer.",
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"disabled": {
+									Type:        schema.TypeBool,
+									Description: "Whether the HTTP load balancing addon is disabled.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"network_config": {
+			Type:        schema.TypeList,
+			Description: "The configs for cluster network.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"network_id": {
+						Type:        schema.TypeString,
+						Description: "The ID of private LAN.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"node_pools": {
+			Type:        schema.TypeSet,
+			Description: "The node pools of the cluster.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the node pool.",
+						Computed:    true,
+					},
+					"instance_type": {
+						Type:        schema.TypeString,
+						Description: "The instance type for node pool.",
+						Computed:    true,
+					},
+					"node_count": {
+						Type:        schema.TypeInt,
+						Description: "The node count in this node pool.",
+						Computed:    true,
+					},
+					"nodes": {
+						Type:     schema.TypeSet,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Description: "The name of the node.",
+									Computed:    true,
+								},
+								"availability_zone": {
+									Type:        schema.TypeString,
+									Description: "The availability zone where the node located.",
+									Computed:    true,
+								},
+								"public_ip_address": {
+									Type:        schema.TypeString,
+									Description: "The public IP address of the node.",
+									Computed:    true,
+								},
+								"private_ip_address": {
+									Type:        schema.TypeString,
+									Description: "The private IP address of the node.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
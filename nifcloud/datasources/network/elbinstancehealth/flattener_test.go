@@ -0,0 +1,93 @@
+package elbinstancehealth
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name             string
+		res              *computing.NiftyDescribeInstanceElasticLoadBalancerHealthOutput
+		wantStates       []interface{}
+		wantAllInService bool
+	}{
+		{
+			name: "flattens the response when all instances are in service",
+			res: &computing.NiftyDescribeInstanceElasticLoadBalancerHealthOutput{
+				NiftyDescribeInstanceElasticLoadBalancerHealthResult: &types.NiftyDescribeInstanceElasticLoadBalancerHealthResult{
+					InstanceStates: []types.InstanceStates{
+						{
+							InstanceId:       nifcloud.String("test_instance_id"),
+							InstanceUniqueId: nifcloud.String("test_instance_unique_id"),
+							State:            nifcloud.String("InService"),
+							ReasonCode:       nifcloud.String("N/A"),
+							Description:      nifcloud.String("N/A"),
+						},
+					},
+				},
+			},
+			wantStates: []interface{}{
+				map[string]interface{}{
+					"instance_id":        "test_instance_id",
+					"instance_unique_id": "test_instance_unique_id",
+					"state":              "InService",
+					"reason_code":        "N/A",
+					"description":        "N/A",
+				},
+			},
+			wantAllInService: true,
+		},
+		{
+			name: "flattens the response when some instances are out of service",
+			res: &computing.NiftyDescribeInstanceElasticLoadBalancerHealthOutput{
+				NiftyDescribeInstanceElasticLoadBalancerHealthResult: &types.NiftyDescribeInstanceElasticLoadBalancerHealthResult{
+					InstanceStates: []types.InstanceStates{
+						{InstanceId: nifcloud.String("test_instance_id_1"), State: nifcloud.String("InService")},
+						{InstanceId: nifcloud.String("test_instance_id_2"), State: nifcloud.String("OutOfService")},
+					},
+				},
+			},
+			wantStates: []interface{}{
+				map[string]interface{}{
+					"instance_id":        "test_instance_id_1",
+					"instance_unique_id": "",
+					"state":              "InService",
+					"reason_code":        "",
+					"description":        "",
+				},
+				map[string]interface{}{
+					"instance_id":        "test_instance_id_2",
+					"instance_unique_id": "",
+					"state":              "OutOfService",
+					"reason_code":        "",
+					"description":        "",
+				},
+			},
+			wantAllInService: false,
+		},
+		{
+			name: "flattens the response when no instances are registered",
+			res: &computing.NiftyDescribeInstanceElasticLoadBalancerHealthOutput{
+				NiftyDescribeInstanceElasticLoadBalancerHealthResult: &types.NiftyDescribeInstanceElasticLoadBalancerHealthResult{},
+			},
+			wantStates:       []interface{}{},
+			wantAllInService: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+			err := flatten(d, tt.res)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStates, d.Get("instance_states"))
+			assert.Equal(t, tt.wantAllInService, d.Get("all_in_service"))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package elbinstancehealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	protocol := d.Get("protocol").(string)
+	lbPort := d.Get("lb_port").(int)
+	instancePort := d.Get("instance_port").(int)
+
+	input := &computing.NiftyDescribeInstanceElasticLoadBalancerHealthInput{
+		ElasticLoadBalancerPort: nifcloud.Int32(int32(lbPort)),
+		InstancePort:            nifcloud.Int32(int32(instancePort)),
+		Protocol:                types.ProtocolOfNiftyDescribeInstanceElasticLoadBalancerHealthRequest(protocol),
+	}
+
+	var elb string
+	if v, ok := d.GetOk("elb_id"); ok {
+		elb = v.(string)
+		input.ElasticLoadBalancerId = nifcloud.String(elb)
+	} else {
+		elb = d.Get("elb_name").(string)
+		input.ElasticLoadBalancerName = nifcloud.String(elb)
+	}
+
+	if v, ok := d.GetOk("instance_ids"); ok {
+		var instances []types.RequestInstancesOfNiftyDescribeInstanceElasticLoadBalancerHealth
+		for _, id := range v.([]interface{}) {
+			instances = append(instances, types.RequestInstancesOfNiftyDescribeInstanceElasticLoadBalancerHealth{
+				InstanceId: nifcloud.String(id.(string)),
+			})
+		}
+		input.Instances = &types.ListOfRequestInstancesOfNiftyDescribeInstanceElasticLoadBalancerHealth{Member: instances}
+	}
+
+	res, err := svc.NiftyDescribeInstanceElasticLoadBalancerHealth(ctx, input)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && strings.HasPrefix(awsErr.ErrorCode(), "Client.InvalidParameterNotFound.ElasticLoadBalancer") {
+			return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+		}
+		return diag.FromErr(fmt.Errorf("failed reading elb instance health: %s", err))
+	}
+
+	d.SetId(strings.Join([]string{elb, protocol, strconv.Itoa(lbPort), strconv.Itoa(instancePort)}, "_"))
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
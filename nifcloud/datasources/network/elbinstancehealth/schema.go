@@ -0,0 +1,106 @@
+package elbinstancehealth
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the health of the instances registered with a multi load balancer."
+
+// New returns the nifcloud_elb_instance_health data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"elb_id": {
+			Type:         schema.TypeString,
+			Description:  "The id of the multi load balancer. Cannot be specified with elb_name.",
+			Optional:     true,
+			ExactlyOneOf: []string{"elb_id", "elb_name"},
+		},
+		"elb_name": {
+			Type:         schema.TypeString,
+			Description:  "The name of the multi load balancer. Cannot be specified with elb_id.",
+			Optional:     true,
+			ExactlyOneOf: []string{"elb_id", "elb_name"},
+		},
+		"protocol": {
+			Type:         schema.TypeString,
+			Description:  "The protocol of the listener. Valid values are `HTTP` `HTTPS` `TCP` `UDP`.",
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"TCP", "UDP", "HTTP", "HTTPS"}, false),
+		},
+		"lb_port": {
+			Type:         schema.TypeInt,
+			Description:  "The port of the listener for the multi load balancer.",
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"instance_port": {
+			Type:         schema.TypeInt,
+			Description:  "The port on the instance to route to.",
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"instance_ids": {
+			Type:        schema.TypeList,
+			Description: "The list of instance names to get the health of. If omitted, all registered instances are returned.",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"instance_states": {
+			Type:        schema.TypeList,
+			Description: "The health of the instances.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"instance_id": {
+						Type:        schema.TypeString,
+						Description: "The instance name.",
+						Computed:    true,
+					},
+					"instance_unique_id": {
+						Type:        schema.TypeString,
+						Description: "The unique id of the instance.",
+						Computed:    true,
+					},
+					"state": {
+						Type:        schema.TypeString,
+						Description: "The health state of the instance; `InService` or `OutOfService`.",
+						Computed:    true,
+					},
+					"reason_code": {
+						Type:        schema.TypeString,
+						Description: "The reason code of the health state.",
+						Computed:    true,
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Description: "The description of the health state.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"all_in_service": {
+			Type:        schema.TypeBool,
+			Description: "Whether all of the instances are `InService`.",
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package loadbalancerinstancehealth
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeInstanceHealthOutput) error {
+	instanceStates := []map[string]interface{}{}
+	allInService := true
+
+	if res.DescribeInstanceHealthResult != nil {
+		for _, s := range res.DescribeInstanceHealthResult.InstanceStates {
+			state := nifcloud.ToString(s.State)
+			if state != "InService" {
+				allInService = false
+			}
+
+			instanceStates = append(instanceStates, map[string]interface{}{
+				"instance_id":        nifcloud.ToString(s.InstanceId),
+				"instance_unique_id": nifcloud.ToString(s.InstanceUniqueId),
+				"state":              state,
+				"reason_code":        nifcloud.ToString(s.ReasonCode),
+				"description":        nifcloud.ToString(s.Description),
+			})
+		}
+	}
+
+	if err := d.Set("instance_states", instanceStates); err != nil {
+		return err
+	}
+
+	return d.Set("all_in_service", len(instanceStates) > 0 && allInService)
+}
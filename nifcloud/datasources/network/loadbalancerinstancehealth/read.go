@@ -0,0 +1,58 @@
+package loadbalancerinstancehealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	lbName := d.Get("load_balancer_name").(string)
+	lbPort := d.Get("load_balancer_port").(int)
+	instancePort := d.Get("instance_port").(int)
+
+	input := &computing.DescribeInstanceHealthInput{
+		LoadBalancerName: nifcloud.String(lbName),
+		LoadBalancerPort: nifcloud.Int32(int32(lbPort)),
+		InstancePort:     nifcloud.Int32(int32(instancePort)),
+	}
+
+	if v, ok := d.GetOk("instance_ids"); ok {
+		var instances []types.RequestInstancesOfDescribeInstanceHealth
+		for _, id := range v.([]interface{}) {
+			instances = append(instances, types.RequestInstancesOfDescribeInstanceHealth{
+				InstanceId: nifcloud.String(id.(string)),
+			})
+		}
+		input.Instances = &types.ListOfRequestInstancesOfDescribeInstanceHealth{Member: instances}
+	}
+
+	res, err := svc.DescribeInstanceHealth(ctx, input)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.LoadBalancerName" {
+			return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+		}
+		return diag.FromErr(fmt.Errorf("failed reading load balancer instance health: %s", err))
+	}
+
+	d.SetId(strings.Join([]string{lbName, strconv.Itoa(lbPort), strconv.Itoa(instancePort)}, "_"))
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package loadbalancerinstancehealth
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the health of the instances registered with a load balancer."
+
+// New returns the nifcloud_load_balancer_instance_health data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"load_balancer_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the load balancer.",
+			Required:    true,
+		},
+		"load_balancer_port": {
+			Type:         schema.TypeInt,
+			Description:  "The port of the load balancer listener.",
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"instance_port": {
+			Type:         schema.TypeInt,
+			Description:  "The port on the instance to route to.",
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"instance_ids": {
+			Type:        schema.TypeList,
+			Description: "The list of instance names to get the health of. If omitted, all registered instances are returned.",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"instance_states": {
+			Type:        schema.TypeList,
+			Description: "The health of the instances.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"instance_id": {
+						Type:        schema.TypeString,
+						Description: "The instance name.",
+						Computed:    true,
+					},
+					"instance_unique_id": {
+						Type:        schema.TypeString,
+						Description: "The unique id of the instance.",
+						Computed:    true,
+					},
+					"state": {
+						Type:        schema.TypeString,
+						Description: "The health state of the instance; `InService` or `OutOfService`.",
+						Computed:    true,
+					},
+					"reason_code": {
+						Type:        schema.TypeString,
+						Description: "The reason code of the health state.",
+						Computed:    true,
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Description: "The description of the health state.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"all_in_service": {
+			Type:        schema.TypeBool,
+			Description: "Whether all of the instances are `InService`.",
+			Computed:    true,
+		},
+	}
+}
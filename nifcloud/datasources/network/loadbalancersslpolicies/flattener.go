@@ -0,0 +1,46 @@
+package loadbalancersslpolicies
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.NiftyDescribeLoadBalancerSSLPoliciesOutput) error {
+	var r *regexp.Regexp
+	if nameRegex := d.Get("name_regex").(string); nameRegex != "" {
+		r = regexp.MustCompile(nameRegex)
+	}
+
+	var ids []string
+	policies := []map[string]interface{}{}
+	if res.NiftyDescribeLoadBalancerSSLPoliciesResult != nil {
+		for _, p := range res.NiftyDescribeLoadBalancerSSLPoliciesResult.SSLPoliciesDescriptions {
+			name := nifcloud.ToString(p.SSLPolicyName)
+			if r != nil && !r.MatchString(name) {
+				continue
+			}
+
+			ciphers := make([]string, len(p.SSLPolicySet))
+			for i, c := range p.SSLPolicySet {
+				ciphers[i] = nifcloud.ToString(c.Cipher)
+			}
+
+			id := strconv.Itoa(int(nifcloud.ToInt32(p.SSLPolicyId)))
+			ids = append(ids, id)
+			policies = append(policies, map[string]interface{}{
+				"ssl_policy_id":   id,
+				"ssl_policy_name": name,
+				"ciphers":         ciphers,
+			})
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+
+	return d.Set("ssl_policies", policies)
+}
@@ -0,0 +1,88 @@
+package loadbalancersslpolicies
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	res := &computing.NiftyDescribeLoadBalancerSSLPoliciesOutput{
+		NiftyDescribeLoadBalancerSSLPoliciesResult: &types.NiftyDescribeLoadBalancerSSLPoliciesResult{
+			SSLPoliciesDescriptions: []types.SSLPoliciesDescriptions{
+				{
+					SSLPolicyId:   nifcloud.Int32(1),
+					SSLPolicyName: nifcloud.String("Standard Ciphers A ver1"),
+					SSLPolicySet: []types.SSLPolicySet{
+						{Cipher: nifcloud.String("ECDHE-RSA-AES256-GCM-SHA384")},
+						{Cipher: nifcloud.String("ECDHE-RSA-AES128-GCM-SHA256")},
+					},
+				},
+				{
+					SSLPolicyId:   nifcloud.Int32(2),
+					SSLPolicyName: nifcloud.String("Ats Ciphers A ver1"),
+					SSLPolicySet: []types.SSLPolicySet{
+						{Cipher: nifcloud.String("ECDHE-RSA-AES256-GCM-SHA384")},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		raw  map[string]interface{}
+		want []interface{}
+	}{
+		{
+			name: "flattens all SSL policies without name_regex",
+			raw:  map[string]interface{}{},
+			want: []interface{}{
+				map[string]interface{}{
+					"ssl_policy_id":   "1",
+					"ssl_policy_name": "Standard Ciphers A ver1",
+					"ciphers":         []interface{}{"ECDHE-RSA-AES256-GCM-SHA384", "ECDHE-RSA-AES128-GCM-SHA256"},
+				},
+				map[string]interface{}{
+					"ssl_policy_id":   "2",
+					"ssl_policy_name": "Ats Ciphers A ver1",
+					"ciphers":         []interface{}{"ECDHE-RSA-AES256-GCM-SHA384"},
+				},
+			},
+		},
+		{
+			name: "flattens SSL policies matching name_regex",
+			raw: map[string]interface{}{
+				"name_regex": "^Ats",
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"ssl_policy_id":   "2",
+					"ssl_policy_name": "Ats Ciphers A ver1",
+					"ciphers":         []interface{}{"ECDHE-RSA-AES256-GCM-SHA384"},
+				},
+			},
+		},
+		{
+			name: "flattens empty list when nothing matches",
+			raw: map[string]interface{}{
+				"name_regex": "^Unknown",
+			},
+			want: []interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, newSchema(), tt.raw)
+			err := flatten(d, res)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, d.Id())
+			assert.Equal(t, tt.want, d.Get("ssl_policies"))
+		})
+	}
+}
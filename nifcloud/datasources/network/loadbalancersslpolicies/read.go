@@ -0,0 +1,32 @@
+package loadbalancersslpolicies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	input := &computing.NiftyDescribeLoadBalancerSSLPoliciesInput{}
+	if v, ok := d.GetOk("load_balancer_name"); ok {
+		input.LoadBalancerName = nifcloud.String(v.(string))
+	}
+
+	res, err := svc.NiftyDescribeLoadBalancerSSLPolicies(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading load balancer SSL policies: %s", err))
+	}
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
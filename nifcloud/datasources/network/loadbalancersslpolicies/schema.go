@@ -0,0 +1,67 @@
+package loadbalancersslpolicies
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the list of SSL policies available for load balancers."
+
+// New returns the nifcloud_load_balancer_ssl_policies data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"load_balancer_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the load balancer to get the SSL policies for.",
+			Optional:    true,
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Description:  "A regex string to filter the SSL policies by name.",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"ssl_policies": {
+			Type:        schema.TypeList,
+			Description: "The list of SSL policies.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ssl_policy_id": {
+						Type:        schema.TypeString,
+						Description: "The id of the SSL policy.",
+						Computed:    true,
+					},
+					"ssl_policy_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the SSL policy.",
+						Computed:    true,
+					},
+					"ciphers": {
+						Type:        schema.TypeList,
+						Description: "The list of ciphers of the SSL policy.",
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package vpnconnectiondeviceconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(
+	d *schema.ResourceData,
+	res *computing.DescribeVpnConnectionsOutput,
+	activities *computing.NiftyDescribeVpnGatewayActivitiesOutput,
+) error {
+	vpnConnection := res.VpnConnectionSet[0]
+
+	if err := d.Set("vpn_gateway_id", vpnConnection.VpnGatewayId); err != nil {
+		return err
+	}
+
+	if err := d.Set("customer_gateway_id", vpnConnection.CustomerGatewayId); err != nil {
+		return err
+	}
+
+	if err := d.Set("customer_gateway_configuration", vpnConnection.CustomerGatewayConfiguration); err != nil {
+		return err
+	}
+
+	if err := d.Set("vpn_gateway_log", activities.Log); err != nil {
+		return err
+	}
+
+	analyzeResults := make([]map[string]interface{}, len(activities.AnalyzeResultSet))
+	for i, r := range activities.AnalyzeResultSet {
+		analyzeResults[i] = map[string]interface{}{
+			"analyze_code": nifcloud.ToString(r.AnalyzeCode),
+			"line":         nifcloud.ToString(r.Line),
+		}
+	}
+
+	if err := d.Set("analyze_result", analyzeResults); err != nil {
+		return err
+	}
+
+	return nil
+}
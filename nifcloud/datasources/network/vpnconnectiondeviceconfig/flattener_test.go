@@ -0,0 +1,43 @@
+package vpnconnectiondeviceconfig
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"vpn_connection_id": "test_vpn_connection_id",
+	})
+	d.SetId("test_vpn_connection_id")
+
+	err := flatten(d, &computing.DescribeVpnConnectionsOutput{
+		VpnConnectionSet: []types.VpnConnectionSet{
+			{
+				VpnConnectionId:              nifcloud.String("test_vpn_connection_id"),
+				VpnGatewayId:                 nifcloud.String("test_vpn_gateway_id"),
+				CustomerGatewayId:            nifcloud.String("test_customer_gateway_id"),
+				CustomerGatewayConfiguration: nifcloud.String("<vpn_connection></vpn_connection>"),
+			},
+		},
+	}, &computing.NiftyDescribeVpnGatewayActivitiesOutput{
+		Log: nifcloud.String("test_log"),
+		AnalyzeResultSet: []types.AnalyzeResultSet{
+			{AnalyzeCode: nifcloud.String("test_analyze_code"), Line: nifcloud.String("test_line")},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "test_vpn_gateway_id", d.Get("vpn_gateway_id"))
+	assert.Equal(t, "test_customer_gateway_id", d.Get("customer_gateway_id"))
+	assert.Equal(t, "<vpn_connection></vpn_connection>", d.Get("customer_gateway_configuration"))
+	assert.Equal(t, "test_log", d.Get("vpn_gateway_log"))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"analyze_code": "test_analyze_code", "line": "test_line"},
+	}, d.Get("analyze_result"))
+}
@@ -0,0 +1,48 @@
+package vpnconnectiondeviceconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+	vpnConnectionID := d.Get("vpn_connection_id").(string)
+
+	res, err := svc.DescribeVpnConnections(ctx, &computing.DescribeVpnConnectionsInput{
+		VpnConnectionId: []string{vpnConnectionID},
+	})
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.VpnConnectionId" {
+			return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+		}
+		return diag.FromErr(fmt.Errorf("failed reading vpn connection: %s", err))
+	}
+
+	if len(res.VpnConnectionSet) < 1 {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	activities, err := svc.NiftyDescribeVpnGatewayActivities(ctx, &computing.NiftyDescribeVpnGatewayActivitiesInput{
+		VpnGatewayId: res.VpnConnectionSet[0].VpnGatewayId,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading vpn gateway activities: %s", err))
+	}
+
+	d.SetId(vpnConnectionID)
+
+	if err := flatten(d, res, activities); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package vpnconnectiondeviceconfig
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get the customer gateway device configuration of a vpn connection."
+
+// New returns the nifcloud_vpn_connection_device_config data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"vpn_connection_id": {
+			Type:        schema.TypeString,
+			Description: "The id of the vpn connection.",
+			Required:    true,
+		},
+		"vpn_gateway_id": {
+			Type:        schema.TypeString,
+			Description: "The id of the vpn gateway of the vpn connection.",
+			Computed:    true,
+		},
+		"customer_gateway_id": {
+			Type:        schema.TypeString,
+			Description: "The id of the customer gateway of the vpn connection.",
+			Computed:    true,
+		},
+		"customer_gateway_configuration": {
+			Type:        schema.TypeString,
+			Description: "The configuration of the customer gateway device in XML format.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"vpn_gateway_log": {
+			Type:        schema.TypeString,
+			Description: "The log of the vpn gateway.",
+			Computed:    true,
+		},
+		"analyze_result": {
+			Type:        schema.TypeList,
+			Description: "The analysis results of the vpn gateway log.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"analyze_code": {
+						Type:        schema.TypeString,
+						Description: "The code of the analysis result.",
+						Computed:    true,
+					},
+					"line": {
+						Type:        schema.TypeString,
+						Description: "The log line of the analysis result.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
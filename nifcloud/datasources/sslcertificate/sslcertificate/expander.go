@@ -0,0 +1,18 @@
+package sslcertificate
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func expandDescribeSSLCertificatesInput(d *schema.ResourceData) *computing.DescribeSslCertificatesInput {
+	input := &computing.DescribeSslCertificatesInput{}
+
+	if v, ok := d.GetOk("fqdn_id"); ok {
+		input.FqdnId = []string{v.(string)}
+	} else {
+		input.Fqdn = []string{d.Get("fqdn").(string)}
+	}
+
+	return input
+}
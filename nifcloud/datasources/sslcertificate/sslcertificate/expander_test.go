@@ -0,0 +1,42 @@
+package sslcertificate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandDescribeSSLCertificatesInput(t *testing.T) {
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeSslCertificatesInput
+	}{
+		{
+			name: "expands the resource data with fqdn_id",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"fqdn_id": "test_fqdn_id",
+			}),
+			want: &computing.DescribeSslCertificatesInput{
+				FqdnId: []string{"test_fqdn_id"},
+			},
+		},
+		{
+			name: "expands the resource data with fqdn",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"fqdn": "test_fqdn",
+			}),
+			want: &computing.DescribeSslCertificatesInput{
+				Fqdn: []string{"test_fqdn"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeSSLCertificatesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package sslcertificate
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeSslCertificatesOutput) error {
+	sslCertificate := newestCertificate(res.CertsSet)
+
+	d.SetId(nifcloud.ToString(sslCertificate.FqdnId))
+
+	if err := d.Set("fqdn_id", sslCertificate.FqdnId); err != nil {
+		return err
+	}
+
+	if err := d.Set("fqdn", sslCertificate.Fqdn); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", sslCertificate.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("cert_authority", sslCertificate.CertAuthority); err != nil {
+		return err
+	}
+
+	if err := d.Set("key_length", sslCertificate.KeyLength); err != nil {
+		return err
+	}
+
+	if err := d.Set("cert_state", sslCertificate.CertState); err != nil {
+		return err
+	}
+
+	if sslCertificate.Period != nil {
+		if err := d.Set("start_date", flattenDate(sslCertificate.Period.StartDate)); err != nil {
+			return err
+		}
+
+		if err := d.Set("end_date", flattenDate(sslCertificate.Period.EndDate)); err != nil {
+			return err
+		}
+
+		if err := d.Set("validity_term", sslCertificate.Period.ValidityTerm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newestCertificate returns the certificate whose validity starts last.
+func newestCertificate(certs []types.CertsSet) types.CertsSet {
+	newest := certs[0]
+	for _, c := range certs[1:] {
+		if startDate(c).After(startDate(newest)) {
+			newest = c
+		}
+	}
+	return newest
+}
+
+func startDate(c types.CertsSet) time.Time {
+	if c.Period == nil || c.Period.StartDate == nil {
+		return time.Time{}
+	}
+	return *c.Period.StartDate
+}
+
+func flattenDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
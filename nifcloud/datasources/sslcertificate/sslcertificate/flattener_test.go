@@ -0,0 +1,66 @@
+package sslcertificate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	oldStart := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldEnd := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newStart := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newEnd := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	d := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"fqdn": "test_fqdn",
+	})
+
+	err := flatten(d, &computing.DescribeSslCertificatesOutput{
+		CertsSet: []types.CertsSet{
+			{
+				FqdnId:        nifcloud.String("test_fqdn_id_old"),
+				Fqdn:          nifcloud.String("test_fqdn"),
+				Description:   nifcloud.String("test_description_old"),
+				CertAuthority: nifcloud.String("test_cert_authority"),
+				KeyLength:     nifcloud.Int32(1024),
+				CertState:     nifcloud.String("valid"),
+				Period: &types.Period{
+					StartDate:    &oldStart,
+					EndDate:      &oldEnd,
+					ValidityTerm: nifcloud.Int32(12),
+				},
+			},
+			{
+				FqdnId:        nifcloud.String("test_fqdn_id_new"),
+				Fqdn:          nifcloud.String("test_fqdn"),
+				Description:   nifcloud.String("test_description_new"),
+				CertAuthority: nifcloud.String("test_cert_authority"),
+				KeyLength:     nifcloud.Int32(2048),
+				CertState:     nifcloud.String("valid"),
+				Period: &types.Period{
+					StartDate:    &newStart,
+					EndDate:      &newEnd,
+					ValidityTerm: nifcloud.Int32(12),
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "test_fqdn_id_new", d.Id())
+	assert.Equal(t, "test_fqdn_id_new", d.Get("fqdn_id"))
+	assert.Equal(t, "test_fqdn", d.Get("fqdn"))
+	assert.Equal(t, "test_description_new", d.Get("description"))
+	assert.Equal(t, "test_cert_authority", d.Get("cert_authority"))
+	assert.Equal(t, 2048, d.Get("key_length"))
+	assert.Equal(t, "valid", d.Get("cert_state"))
+	assert.Equal(t, "2022-01-01T00:00:00Z", d.Get("start_date"))
+	assert.Equal(t, "2023-01-01T00:00:00Z", d.Get("end_date"))
+	assert.Equal(t, 12, d.Get("validity_term"))
+}
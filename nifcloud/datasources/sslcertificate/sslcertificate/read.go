@@ -0,0 +1,29 @@
+package sslcertificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.DescribeSslCertificates(ctx, expandDescribeSSLCertificatesInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading SSLCertificate: %s", err))
+	}
+
+	if len(res.CertsSet) < 1 {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
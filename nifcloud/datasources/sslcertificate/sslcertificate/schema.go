@@ -0,0 +1,77 @@
+package sslcertificate
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about a ssl certificate."
+
+// New returns the nifcloud_ssl_certificate data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"fqdn": {
+			Type:         schema.TypeString,
+			Description:  "The name for the certificate. When several certificates have the same name, the newest one is chosen.",
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"fqdn", "fqdn_id"},
+		},
+		"fqdn_id": {
+			Type:         schema.TypeString,
+			Description:  "The unique identifier for the certificate.",
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"fqdn", "fqdn_id"},
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The SSL certificate description.",
+			Computed:    true,
+		},
+		"cert_authority": {
+			Type:        schema.TypeString,
+			Description: "The issuer of the certificate.",
+			Computed:    true,
+		},
+		"key_length": {
+			Type:        schema.TypeInt,
+			Description: "The key length of the certificate.",
+			Computed:    true,
+		},
+		"start_date": {
+			Type:        schema.TypeString,
+			Description: "The date the certificate becomes valid (RFC3339 format).",
+			Computed:    true,
+		},
+		"end_date": {
+			Type:        schema.TypeString,
+			Description: "The date the certificate expires (RFC3339 format).",
+			Computed:    true,
+		},
+		"validity_term": {
+			Type:        schema.TypeInt,
+			Description: "The validity term of the certificate.",
+			Computed:    true,
+		},
+		"cert_state": {
+			Type:        schema.TypeString,
+			Description: "The state of the certificate.",
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package bucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	bucketresource "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/storage/bucket"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Storage
+	name := d.Get("bucket").(string)
+
+	res, err := svc.GetService(ctx, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading buckets: %s", err))
+	}
+
+	bucket, found := bucketresource.FindBucket(res.Buckets, name)
+	if !found {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	versioningRes, err := svc.GetBucketVersioning(ctx, &storage.GetBucketVersioningInput{
+		Bucket: nifcloud.String(name),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading bucket versioning: %s", err))
+	}
+
+	policyRes, err := svc.GetBucketPolicy(ctx, &storage.GetBucketPolicyInput{
+		Bucket: nifcloud.String(name),
+	})
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "NoSuchBucketPolicy" {
+			policyRes = nil
+		} else {
+			return diag.FromErr(fmt.Errorf("failed reading bucket policy: %s", err))
+		}
+	}
+
+	d.SetId(name)
+
+	if err := bucketresource.Flatten(d, bucket, versioningRes, policyRes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if bucket.CreationDate != nil {
+		if err := d.Set("creation_date", bucket.CreationDate.Format(time.RFC3339)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
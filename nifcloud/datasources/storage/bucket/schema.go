@@ -0,0 +1,57 @@
+package bucket
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about a storage bucket."
+
+// New returns the nifcloud_storage_bucket data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:        schema.TypeString,
+			Description: "The name of the bucket.",
+			Required:    true,
+		},
+		"creation_date": {
+			Type:        schema.TypeString,
+			Description: "The date the bucket was created (RFC3339 format).",
+			Computed:    true,
+		},
+		"versioning": {
+			Type:        schema.TypeList,
+			Description: "A configuration of the bucket versioning state.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Description: "Whether versioning is enabled.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"policy": {
+			Type:        schema.TypeString,
+			Description: "A bucket policy JSON document.",
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package object
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+)
+
+func expandHeadObjectInput(d *schema.ResourceData) *storage.HeadObjectInput {
+	input := &storage.HeadObjectInput{
+		Bucket: nifcloud.String(d.Get("bucket").(string)),
+		Object: nifcloud.String(d.Get("key").(string)),
+	}
+
+	if v, ok := d.GetOk("version_id"); ok {
+		input.VersionId = nifcloud.String(v.(string))
+	}
+
+	return input
+}
+
+func expandGetObjectInput(d *schema.ResourceData) *storage.GetObjectInput {
+	input := &storage.GetObjectInput{
+		Bucket: nifcloud.String(d.Get("bucket").(string)),
+		Object: nifcloud.String(d.Get("key").(string)),
+	}
+
+	if v, ok := d.GetOk("version_id"); ok {
+		input.VersionId = nifcloud.String(v.(string))
+	}
+
+	return input
+}
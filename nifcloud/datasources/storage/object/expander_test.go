@@ -0,0 +1,79 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandHeadObjectInput(t *testing.T) {
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *storage.HeadObjectInput
+	}{
+		{
+			name: "expands the resource data",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"bucket": "test_bucket",
+				"key":    "test/key.txt",
+			}),
+			want: &storage.HeadObjectInput{
+				Bucket: nifcloud.String("test_bucket"),
+				Object: nifcloud.String("test/key.txt"),
+			},
+		},
+		{
+			name: "expands the resource data with version_id",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"bucket":     "test_bucket",
+				"key":        "test/key.txt",
+				"version_id": "test_version_id",
+			}),
+			want: &storage.HeadObjectInput{
+				Bucket:    nifcloud.String("test_bucket"),
+				Object:    nifcloud.String("test/key.txt"),
+				VersionId: nifcloud.String("test_version_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandHeadObjectInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandGetObjectInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"bucket":     "test_bucket",
+		"key":        "test/key.txt",
+		"version_id": "test_version_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *storage.GetObjectInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &storage.GetObjectInput{
+				Bucket:    nifcloud.String("test_bucket"),
+				Object:    nifcloud.String("test/key.txt"),
+				VersionId: nifcloud.String("test_version_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandGetObjectInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
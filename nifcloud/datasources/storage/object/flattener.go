@@ -0,0 +1,107 @@
+package object
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+)
+
+const metadataHeaderPrefix = "X-Amz-Meta-"
+
+// allowedContentTypes is the list of non text/* content types whose body is exported.
+var allowedContentTypes = []string{
+	"application/json",
+	"application/xml",
+	"application/javascript",
+	"application/x-sh",
+	"application/x-yaml",
+	"application/yaml",
+}
+
+func flatten(d *schema.ResourceData, headRes *storage.HeadObjectOutput, getRes *storage.GetObjectOutput) error {
+	if err := d.Set("content_type", headRes.ContentType); err != nil {
+		return err
+	}
+
+	if err := d.Set("etag", strings.Trim(nifcloud.ToString(headRes.ETag), `"`)); err != nil {
+		return err
+	}
+
+	if err := d.Set("last_modified", headRes.LastModified); err != nil {
+		return err
+	}
+
+	if err := d.Set("expiration", headRes.XAmzExpiration); err != nil {
+		return err
+	}
+
+	if err := d.Set("server_side_encryption", headRes.XAmzServerSideEncryption); err != nil {
+		return err
+	}
+
+	if headRes.XAmzVersionId != nil {
+		if err := d.Set("version_id", headRes.XAmzVersionId); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("metadata", flattenMetadata(headRes)); err != nil {
+		return err
+	}
+
+	body := ""
+	if getRes != nil {
+		body = string(getRes.Body)
+	}
+
+	if err := d.Set("body", body); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenMetadata(headRes *storage.HeadObjectOutput) map[string]interface{} {
+	res := map[string]interface{}{}
+
+	rawRes, ok := awsmiddleware.GetRawResponse(headRes.ResultMetadata).(*smithyhttp.Response)
+	if !ok || rawRes == nil {
+		return res
+	}
+
+	for k, v := range rawRes.Header {
+		k = http.CanonicalHeaderKey(k)
+		name := strings.ToLower(strings.TrimPrefix(k, metadataHeaderPrefix))
+		if !strings.HasPrefix(k, metadataHeaderPrefix) || name == "" || len(v) == 0 {
+			continue
+		}
+		res[name] = v[0]
+	}
+
+	return res
+}
+
+func isContentTypeAllowed(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	if strings.HasPrefix(mediaType, "text/") {
+		return true
+	}
+
+	for _, t := range allowedContentTypes {
+		if mediaType == t {
+			return true
+		}
+	}
+
+	return false
+}
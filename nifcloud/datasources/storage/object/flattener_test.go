@@ -0,0 +1,77 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	headRes := &storage.HeadObjectOutput{
+		ContentType:   nifcloud.String("text/plain; charset=utf-8"),
+		ETag:          nifcloud.String(`"test_etag"`),
+		LastModified:  nifcloud.String("Mon, 01 Aug 2022 00:00:00 GMT"),
+		XAmzVersionId: nifcloud.String("test_version_id"),
+	}
+
+	tests := []struct {
+		name     string
+		getRes   *storage.GetObjectOutput
+		wantBody string
+	}{
+		{
+			name: "flattens the response with body",
+			getRes: &storage.GetObjectOutput{
+				Body: []byte("test_body"),
+			},
+			wantBody: "test_body",
+		},
+		{
+			name:     "flattens the response without body",
+			wantBody: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"bucket": "test_bucket",
+				"key":    "test/key.txt",
+			})
+
+			err := flatten(d, headRes, tt.getRes)
+			assert.NoError(t, err)
+
+			assert.Equal(t, "text/plain; charset=utf-8", d.Get("content_type"))
+			assert.Equal(t, "test_etag", d.Get("etag"))
+			assert.Equal(t, "Mon, 01 Aug 2022 00:00:00 GMT", d.Get("last_modified"))
+			assert.Equal(t, "test_version_id", d.Get("version_id"))
+			assert.Equal(t, map[string]interface{}{}, d.Get("metadata"))
+			assert.Equal(t, tt.wantBody, d.Get("body"))
+		})
+	}
+}
+
+func TestIsContentTypeAllowed(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        bool
+	}{
+		{contentType: "text/plain", want: true},
+		{contentType: "text/x-shellscript; charset=utf-8", want: true},
+		{contentType: "application/json", want: true},
+		{contentType: "application/x-yaml", want: true},
+		{contentType: "application/octet-stream", want: false},
+		{contentType: "image/png", want: false},
+		{contentType: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			assert.Equal(t, tt.want, isContentTypeAllowed(tt.contentType))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Storage
+
+	headRes, err := svc.HeadObject(ctx, expandHeadObjectInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading object %s in bucket %s: %s", d.Get("key"), d.Get("bucket"), err))
+	}
+
+	var getRes *storage.GetObjectOutput
+	if isContentTypeAllowed(nifcloud.ToString(headRes.ContentType)) {
+		getRes, err = svc.GetObject(ctx, expandGetObjectInput(d))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed reading object body %s in bucket %s: %s", d.Get("key"), d.Get("bucket"), err))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket"), d.Get("key")))
+
+	if err := flatten(d, headRes, getRes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
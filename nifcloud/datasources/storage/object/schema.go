@@ -0,0 +1,82 @@
+package object
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get the metadata and optionally the content of an object stored in a storage bucket."
+
+// New returns the nifcloud_storage_object data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:        schema.TypeString,
+			Description: "The name of the bucket to read the object from.",
+			Required:    true,
+		},
+		"key": {
+			Type:        schema.TypeString,
+			Description: "The full path to the object inside the bucket.",
+			Required:    true,
+		},
+		"version_id": {
+			Type:        schema.TypeString,
+			Description: "The specific version ID of the object. Defaults to the latest version.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"body": {
+			Type:        schema.TypeString,
+			Description: "The object data. It is available only for objects with a human-readable `content_type` (`text/*` and some `application/*` types).",
+			Computed:    true,
+		},
+		"content_type": {
+			Type:        schema.TypeString,
+			Description: "A standard MIME type describing the format of the object data.",
+			Computed:    true,
+		},
+		"etag": {
+			Type:        schema.TypeString,
+			Description: "The ETag generated for the object.",
+			Computed:    true,
+		},
+		"last_modified": {
+			Type:        schema.TypeString,
+			Description: "The last modified date of the object.",
+			Computed:    true,
+		},
+		"expiration": {
+			Type:        schema.TypeString,
+			Description: "The expiration date and rule ID of the object if the object is expired by a lifecycle rule.",
+			Computed:    true,
+		},
+		"server_side_encryption": {
+			Type:        schema.TypeString,
+			Description: "The server-side encryption algorithm used when storing the object.",
+			Computed:    true,
+		},
+		"metadata": {
+			Type:        schema.TypeMap,
+			Description: "A map of user-defined metadata (`x-amz-meta-*` headers) stored with the object.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
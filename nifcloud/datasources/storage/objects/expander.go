@@ -0,0 +1,26 @@
+package objects
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+)
+
+func expandGetBucketInput(d *schema.ResourceData, marker string, maxKeys int) *storage.GetBucketInput {
+	input := &storage.GetBucketInput{
+		Bucket:  nifcloud.String(d.Get("bucket").(string)),
+		MaxKeys: nifcloud.String(strconv.Itoa(maxKeys)),
+	}
+
+	if v, ok := d.GetOk("prefix"); ok {
+		input.Prefix = nifcloud.String(v.(string))
+	}
+
+	if marker != "" {
+		input.Marker = nifcloud.String(marker)
+	}
+
+	return input
+}
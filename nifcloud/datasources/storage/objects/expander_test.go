@@ -0,0 +1,51 @@
+package objects
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandGetBucketInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"bucket": "test_bucket",
+		"prefix": "test/",
+	})
+
+	tests := []struct {
+		name    string
+		marker  string
+		maxKeys int
+		want    *storage.GetBucketInput
+	}{
+		{
+			name:    "expands the resource data",
+			maxKeys: 1000,
+			want: &storage.GetBucketInput{
+				Bucket:  nifcloud.String("test_bucket"),
+				Prefix:  nifcloud.String("test/"),
+				MaxKeys: nifcloud.String("1000"),
+			},
+		},
+		{
+			name:    "expands the resource data with marker",
+			marker:  "test/key.txt",
+			maxKeys: 10,
+			want: &storage.GetBucketInput{
+				Bucket:  nifcloud.String("test_bucket"),
+				Prefix:  nifcloud.String("test/"),
+				MaxKeys: nifcloud.String("10"),
+				Marker:  nifcloud.String("test/key.txt"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandGetBucketInput(rd, tt.marker, tt.maxKeys)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
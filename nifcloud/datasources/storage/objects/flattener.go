@@ -0,0 +1,52 @@
+package objects
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
+)
+
+func flatten(d *schema.ResourceData, contents []types.Contents) error {
+	keys := make([]string, len(contents))
+	objects := make([]map[string]interface{}, len(contents))
+
+	for i, c := range contents {
+		size := 0
+		if c.Size != nil {
+			s, err := strconv.Atoi(nifcloud.ToString(c.Size))
+			if err != nil {
+				return fmt.Errorf("invalid size of object %s: %s", nifcloud.ToString(c.Key), err)
+			}
+			size = s
+		}
+
+		lastModified := ""
+		if c.LastModified != nil {
+			lastModified = c.LastModified.Format(time.RFC3339)
+		}
+
+		keys[i] = nifcloud.ToString(c.Key)
+		objects[i] = map[string]interface{}{
+			"key":           nifcloud.ToString(c.Key),
+			"etag":          strings.Trim(nifcloud.ToString(c.ETag), `"`),
+			"size":          size,
+			"last_modified": lastModified,
+			"storage_class": nifcloud.ToString(c.StorageClass),
+		}
+	}
+
+	if err := d.Set("keys", keys); err != nil {
+		return err
+	}
+
+	if err := d.Set("objects", objects); err != nil {
+		return err
+	}
+
+	return nil
+}
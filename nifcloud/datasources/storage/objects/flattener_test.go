@@ -0,0 +1,60 @@
+package objects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	lastModified := time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	d := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"bucket": "test_bucket",
+	})
+
+	err := flatten(d, []types.Contents{
+		{
+			Key:          nifcloud.String("test/a.txt"),
+			ETag:         nifcloud.String(`"test_etag_a"`),
+			Size:         nifcloud.String("10"),
+			LastModified: &lastModified,
+			StorageClass: nifcloud.String("STANDARD"),
+		},
+		{
+			Key:  nifcloud.String("test/b.txt"),
+			ETag: nifcloud.String(`"test_etag_b"`),
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []interface{}{"test/a.txt", "test/b.txt"}, d.Get("keys"))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"key":           "test/a.txt",
+			"etag":          "test_etag_a",
+			"size":          10,
+			"last_modified": "2022-08-01T00:00:00Z",
+			"storage_class": "STANDARD",
+		},
+		map[string]interface{}{
+			"key":           "test/b.txt",
+			"etag":          "test_etag_b",
+			"size":          0,
+			"last_modified": "",
+			"storage_class": "",
+		},
+	}, d.Get("objects"))
+
+	err = flatten(d, []types.Contents{
+		{
+			Key:  nifcloud.String("test/c.txt"),
+			Size: nifcloud.String("invalid"),
+		},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,45 @@
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Storage
+	maxKeys := d.Get("max_keys").(int)
+
+	contents := []types.Contents{}
+	marker := ""
+	for len(contents) < maxKeys {
+		res, err := svc.GetBucket(ctx, expandGetBucketInput(d, marker, maxKeys-len(contents)))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed listing objects in bucket %s: %s", d.Get("bucket"), err))
+		}
+
+		contents = append(contents, res.Contents...)
+
+		if !nifcloud.ToBool(res.IsTruncated) || len(res.Contents) == 0 {
+			break
+		}
+
+		marker = nifcloud.ToString(res.NextMarker)
+		if marker == "" {
+			marker = nifcloud.ToString(res.Contents[len(res.Contents)-1].Key)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket"), d.Get("prefix")))
+
+	if err := flatten(d, contents); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
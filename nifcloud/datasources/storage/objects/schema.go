@@ -0,0 +1,88 @@
+package objects
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the list of objects stored in a storage bucket."
+
+// New returns the nifcloud_storage_objects data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:        schema.TypeString,
+			Description: "The name of the bucket to list the objects from.",
+			Required:    true,
+		},
+		"prefix": {
+			Type:        schema.TypeString,
+			Description: "Limits the results to the object keys that begin with the specified prefix.",
+			Optional:    true,
+		},
+		"max_keys": {
+			Type:         schema.TypeInt,
+			Description:  "The maximum number of keys to return.",
+			Optional:     true,
+			Default:      1000,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"keys": {
+			Type:        schema.TypeList,
+			Description: "The list of object keys.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"objects": {
+			Type:        schema.TypeList,
+			Description: "The list of objects.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Description: "The key of the object.",
+						Computed:    true,
+					},
+					"etag": {
+						Type:        schema.TypeString,
+						Description: "The ETag generated for the object.",
+						Computed:    true,
+					},
+					"size": {
+						Type:        schema.TypeInt,
+						Description: "The size of the object in bytes.",
+						Computed:    true,
+					},
+					"last_modified": {
+						Type:        schema.TypeString,
+						Description: "The last modified date of the object (RFC3339 format).",
+						Computed:    true,
+					},
+					"storage_class": {
+						Type:        schema.TypeString,
+						Description: "The storage class of the object.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package cidr
+
+import (
+	"bytes"
+	"net"
+)
+
+// Contains reports whether the CIDR block contains the IP address.
+// It returns false if either of them is invalid.
+func Contains(cidrBlock, ipAddress string) bool {
+	_, ipnet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return false
+	}
+
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return false
+	}
+
+	return ipnet.Contains(ip)
+}
+
+// CompareIPAddress returns an integer comparing two IP addresses.
+// The result will be 0 if a == b, -1 if a < b, and +1 if a > b.
+// Invalid IP addresses are treated as the smallest value.
+func CompareIPAddress(a, b string) int {
+	return bytes.Compare(net.ParseIP(a).To16(), net.ParseIP(b).To16())
+}
+
+// Overlaps reports whether the two IP address ranges [start1, stop1] and [start2, stop2] overlap.
+func Overlaps(start1, stop1, start2, stop2 string) bool {
+	return CompareIPAddress(start1, stop2) <= 0 && CompareIPAddress(start2, stop1) <= 0
+}
@@ -0,0 +1,123 @@
+package cidr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name      string
+		cidrBlock string
+		ipAddress string
+		want      bool
+	}{
+		{
+			name:      "returns true if the ip address is within the cidr block",
+			cidrBlock: "192.168.1.0/24",
+			ipAddress: "192.168.1.1",
+			want:      true,
+		},
+		{
+			name:      "returns false if the ip address is not within the cidr block",
+			cidrBlock: "192.168.1.0/24",
+			ipAddress: "192.168.2.1",
+			want:      false,
+		},
+		{
+			name:      "returns false for the invalid cidr block",
+			cidrBlock: "192.168.1.0",
+			ipAddress: "192.168.1.1",
+			want:      false,
+		},
+		{
+			name:      "returns false for the invalid ip address",
+			cidrBlock: "192.168.1.0/24",
+			ipAddress: "192.168.1",
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Contains(tt.cidrBlock, tt.ipAddress))
+		})
+	}
+}
+
+func TestCompareIPAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{
+			name: "returns 0 for the equal addresses",
+			a:    "192.168.1.1",
+			b:    "192.168.1.1",
+			want: 0,
+		},
+		{
+			name: "returns -1 for the lesser address",
+			a:    "192.168.1.9",
+			b:    "192.168.1.10",
+			want: -1,
+		},
+		{
+			name: "returns 1 for the greater address",
+			a:    "192.168.2.0",
+			b:    "192.168.1.255",
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompareIPAddress(tt.a, tt.b))
+		})
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	tests := []struct {
+		name   string
+		start1 string
+		stop1  string
+		start2 string
+		stop2  string
+		want   bool
+	}{
+		{
+			name:   "returns false for the separated ranges",
+			start1: "192.168.1.10",
+			stop1:  "192.168.1.20",
+			start2: "192.168.1.21",
+			stop2:  "192.168.1.30",
+			want:   false,
+		},
+		{
+			name:   "returns true for the overlapped ranges",
+			start1: "192.168.1.10",
+			stop1:  "192.168.1.20",
+			start2: "192.168.1.20",
+			stop2:  "192.168.1.30",
+			want:   true,
+		},
+		{
+			name:   "returns true for the included range",
+			start1: "192.168.1.10",
+			stop1:  "192.168.1.100",
+			start2: "192.168.1.20",
+			stop2:  "192.168.1.30",
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Overlaps(tt.start1, tt.stop1, tt.start2, tt.stop2))
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package mutexkv
+
+// elb serializes the resources which update the same elastic load balancer,
+// such as the listeners and instance attachments.
+var elb = NewMutexKV()
+
+func LockELB(id string) {
+	elb.Lock(id)
+}
+
+func UnlockELB(id string) {
+	elb.Unlock(id)
+}
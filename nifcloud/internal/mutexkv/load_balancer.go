@@ -0,0 +1,13 @@
+package mutexkv
+
+// loadBalancer serializes the resources which update the same load balancer,
+// such as the instance attachments and filters.
+var loadBalancer = NewMutexKV()
+
+func LockLoadBalancer(name string) {
+	loadBalancer.Lock(name)
+}
+
+func UnlockLoadBalancer(name string) {
+	loadBalancer.Unlock(name)
+}
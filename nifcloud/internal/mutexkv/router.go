@@ -0,0 +1,13 @@
+package mutexkv
+
+// router serializes the resources which update the same router,
+// such as nifcloud_router itself and the route table, NAT table, network interface and elastic IP associations.
+var router = NewMutexKV()
+
+func LockRouter(id string) {
+	router.Lock(id)
+}
+
+func UnlockRouter(id string) {
+	router.Unlock(id)
+}
@@ -0,0 +1,38 @@
+package rawconfig
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// Elements returns the elements of the list or set value.
+// Unknown elements are kept so that the index of each element matches the attribute path.
+// It returns nil if the value itself is unknown or null.
+func Elements(v cty.Value) []cty.Value {
+	if !v.IsKnown() || v.IsNull() || !v.CanIterateElements() {
+		return nil
+	}
+
+	var elements []cty.Value
+	for it := v.ElementIterator(); it.Next(); {
+		_, e := it.Element()
+		elements = append(elements, e)
+	}
+	return elements
+}
+
+// String returns the string attribute of the object value.
+// The second return value is false if the attribute is unknown, null or empty,
+// which means the attribute can not be validated at plan time.
+func String(v cty.Value, name string) (string, bool) {
+	if !v.IsKnown() || v.IsNull() || !v.Type().IsObjectType() || !v.Type().HasAttribute(name) {
+		return "", false
+	}
+
+	a := v.GetAttr(name)
+	if !a.IsKnown() || a.IsNull() || !a.Type().Equals(cty.String) {
+		return "", false
+	}
+
+	s := a.AsString()
+	return s, s != ""
+}
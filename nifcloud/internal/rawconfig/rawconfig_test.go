@@ -0,0 +1,93 @@
+package rawconfig
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElements(t *testing.T) {
+	known := cty.ObjectVal(map[string]cty.Value{"ip_address": cty.StringVal("192.168.1.1")})
+
+	tests := []struct {
+		name  string
+		value cty.Value
+		want  []cty.Value
+	}{
+		{
+			name:  "returns the elements",
+			value: cty.SetVal([]cty.Value{known}),
+			want:  []cty.Value{known},
+		},
+		{
+			name:  "returns nil for the null value",
+			value: cty.NullVal(cty.Set(known.Type())),
+			want:  nil,
+		},
+		{
+			name:  "returns nil for the unknown value",
+			value: cty.UnknownVal(cty.Set(known.Type())),
+			want:  nil,
+		},
+		{
+			name:  "keeps the unknown element",
+			value: cty.ListVal([]cty.Value{known, cty.UnknownVal(known.Type())}),
+			want:  []cty.Value{known, cty.UnknownVal(known.Type())},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Elements(tt.value))
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  cty.Value
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "returns the known value",
+			value:  cty.ObjectVal(map[string]cty.Value{"ip_address": cty.StringVal("192.168.1.1")}),
+			want:   "192.168.1.1",
+			wantOk: true,
+		},
+		{
+			name:   "returns false for the empty string",
+			value:  cty.ObjectVal(map[string]cty.Value{"ip_address": cty.StringVal("")}),
+			want:   "",
+			wantOk: false,
+		},
+		{
+			name:   "returns nil for the null value",
+			value:  cty.ObjectVal(map[string]cty.Value{"ip_address": cty.NullVal(cty.String)}),
+			want:   "",
+			wantOk: false,
+		},
+		{
+			name:   "returns nil for the unknown value",
+			value:  cty.ObjectVal(map[string]cty.Value{"ip_address": cty.UnknownVal(cty.String)}),
+			want:   "",
+			wantOk: false,
+		},
+		{
+			name:   "returns false for the missing attribute",
+			value:  cty.ObjectVal(map[string]cty.Value{"network_id": cty.StringVal("net-COMMON_GLOBAL")}),
+			want:   "",
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := String(tt.value, "ip_address")
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package rawconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SuppressRemovalIfConfigured returns a DiffSuppressFunc which suppresses the removal of the attribute
+// when the other attribute is configured and unchanged.
+// It is used for a pair of network id and name attributes; both are read after import
+// though only one of them is in the config.
+func SuppressRemovalIfConfigured(other string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if new != "" {
+			return false
+		}
+
+		if _, ok := String(d.GetRawConfig(), other); !ok {
+			return false
+		}
+
+		return !d.HasChange(other)
+	}
+}
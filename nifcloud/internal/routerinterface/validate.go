@@ -0,0 +1,114 @@
+package routerinterface
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/cidr"
+)
+
+// Validate checks that the IP address of the router network interface and
+// the addresses in the DHCP config are within the CIDR block of the private LAN to attach.
+// Empty arguments are treated as unknown values and are not validated.
+// The returned error message starts with the name of the offending attribute.
+func Validate(ctx context.Context, svc *computing.Client, networkID, networkName, ipAddress, dhcpConfigID string) error {
+	if networkID == "net-COMMON_GLOBAL" || networkID == "net-COMMON_PRIVATE" {
+		return nil
+	}
+
+	if ipAddress == "" && dhcpConfigID == "" {
+		return nil
+	}
+
+	input := &computing.NiftyDescribePrivateLansInput{}
+	attribute := "network_id"
+	switch {
+	case networkID != "":
+		input.NetworkId = []string{networkID}
+	case networkName != "":
+		input.PrivateLanName = []string{networkName}
+		attribute = "network_name"
+	default:
+		return nil
+	}
+
+	lans, err := svc.NiftyDescribePrivateLans(ctx, input)
+	if err != nil {
+		return fmt.Errorf("%s: failed to read the private lan: %s", attribute, err)
+	}
+
+	// The private lan which is not found yet is created in the same apply.
+	if len(lans.PrivateLanSet) != 1 {
+		return nil
+	}
+	lan := lans.PrivateLanSet[0]
+
+	var pools []ipAddressRange
+	var staticIPAddresses []string
+	if dhcpConfigID != "" {
+		configs, err := svc.NiftyDescribeDhcpConfigs(ctx, &computing.NiftyDescribeDhcpConfigsInput{
+			DhcpConfigId: []string{dhcpConfigID},
+		})
+		if err != nil {
+			return fmt.Errorf("dhcp_config_id: failed to read the dhcp config: %s", err)
+		}
+
+		for _, c := range configs.DhcpConfigsSet {
+			for _, p := range c.IpAddressPoolsSet {
+				pools = append(pools, ipAddressRange{
+					start: nifcloud.ToString(p.StartIpAddress),
+					stop:  nifcloud.ToString(p.StopIpAddress),
+				})
+			}
+			for _, m := range c.StaticMappingsSet {
+				staticIPAddresses = append(staticIPAddresses, nifcloud.ToString(m.IpAddress))
+			}
+		}
+	}
+
+	return validateAddressesInCIDR(
+		nifcloud.ToString(lan.NetworkId),
+		nifcloud.ToString(lan.CidrBlock),
+		ipAddress,
+		dhcpConfigID,
+		pools,
+		staticIPAddresses,
+	)
+}
+
+type ipAddressRange struct {
+	start string
+	stop  string
+}
+
+func validateAddressesInCIDR(
+	networkID, cidrBlock, ipAddress, dhcpConfigID string,
+	pools []ipAddressRange,
+	staticIPAddresses []string,
+) error {
+	if ipAddress != "" && !cidr.Contains(cidrBlock, ipAddress) {
+		return fmt.Errorf("ip_address: %q is not within the cidr_block %q of %s", ipAddress, cidrBlock, networkID)
+	}
+
+	for _, p := range pools {
+		if !cidr.Contains(cidrBlock, p.start) || !cidr.Contains(cidrBlock, p.stop) {
+			return fmt.Errorf(
+				"dhcp_config_id: ipaddress_pool %s-%s of %q is not within the cidr_block %q of %s",
+				p.start, p.stop, dhcpConfigID, cidrBlock, networkID,
+			)
+		}
+	}
+
+	for _, ip := range staticIPAddresses {
+		if !cidr.Contains(cidrBlock, ip) {
+			return fmt.Errorf(
+				"dhcp_config_id: static_mapping_ipaddress %q of %q is not within the cidr_block %q of %s",
+				ip, dhcpConfigID, cidrBlock, networkID,
+			)
+		}
+	}
+
+	return nil
+}
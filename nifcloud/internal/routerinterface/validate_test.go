@@ -0,0 +1,76 @@
+package routerinterface
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAddressesInCIDR(t *testing.T) {
+	type args struct {
+		ipAddress         string
+		pools             []ipAddressRange
+		staticIPAddresses []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr string
+	}{
+		{
+			name: "accepts the addresses within the cidr block",
+			args: args{
+				ipAddress:         "192.168.1.1",
+				pools:             []ipAddressRange{{start: "192.168.1.10", stop: "192.168.1.20"}},
+				staticIPAddresses: []string{"192.168.1.100"},
+			},
+		},
+		{
+			name: "ignores the unknown ip address",
+			args: args{
+				ipAddress: "",
+			},
+		},
+		{
+			name: "returns an error for the ip address outside the cidr block",
+			args: args{
+				ipAddress: "192.168.2.1",
+			},
+			wantErr: `ip_address: "192.168.2.1" is not within the cidr_block "192.168.1.0/24" of test_network_id`,
+		},
+		{
+			name: "returns an error for the pool outside the cidr block",
+			args: args{
+				ipAddress: "192.168.1.1",
+				pools:     []ipAddressRange{{start: "192.168.1.200", stop: "192.168.2.10"}},
+			},
+			wantErr: `dhcp_config_id: ipaddress_pool 192.168.1.200-192.168.2.10 of "test_dhcp_config_id" is not within the cidr_block "192.168.1.0/24" of test_network_id`,
+		},
+		{
+			name: "returns an error for the static mapping outside the cidr block",
+			args: args{
+				ipAddress:         "192.168.1.1",
+				staticIPAddresses: []string{"10.0.0.1"},
+			},
+			wantErr: `dhcp_config_id: static_mapping_ipaddress "10.0.0.1" of "test_dhcp_config_id" is not within the cidr_block "192.168.1.0/24" of test_network_id`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAddressesInCIDR(
+				"test_network_id",
+				"192.168.1.0/24",
+				tt.args.ipAddress,
+				"test_dhcp_config_id",
+				tt.args.pools,
+				tt.args.staticIPAddresses,
+			)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,15 +2,38 @@ package nifcloud
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/image"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/availabilityzones"
+	imagedatasource "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/image"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/instancesnapshots"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/instancetypes"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/regions"
+	securitygroupdatasource "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/securitygroup"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/securitygrouprules"
+	clusterdatasource "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/hatoba/cluster"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/network/elbinstancehealth"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/network/loadbalancerinstancehealth"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/network/loadbalancersslpolicies"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/network/vpnconnectiondeviceconfig"
+	sslcertificatedatasource "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/sslcertificate/sslcertificate"
+	bucketdatasource "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/storage/bucket"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/storage/object"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/storage/objects"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/alarm"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/autoscalinggroup"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/elasticip"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/elasticipassociation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/image"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/instance"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/instancebackuprule"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/instancesnapshot"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/keypair"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/multiipaddressgroup"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/networkinterface"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/securitygroup"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/securitygrouprule"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/separateinstancerule"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/volume"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/volumeattachment"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/dns/record"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/dns/zone"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/ess/domaindkim"
@@ -24,13 +47,21 @@ import (
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/dhcpconfig"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/dhcpoption"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/elb"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/elbinstanceattachment"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/elblistener"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/loadbalancer"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/loadbalancerfilter"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/loadbalancerinstanceattachment"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/loadbalancerlistener"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/natrule"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/nattable"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/nattableassociation"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/privatelan"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/route"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/router"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/routernetworkinterface"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/routetable"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/routetableassociation"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/vpnconnection"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/vpngateway"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/network/webproxy"
@@ -88,45 +119,76 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"nifcloud_image": image.New(),
+			"nifcloud_availability_zones":            availabilityzones.New(),
+			"nifcloud_elb_instance_health":           elbinstancehealth.New(),
+			"nifcloud_hatoba_cluster":                clusterdatasource.New(),
+			"nifcloud_image":                         imagedatasource.New(),
+			"nifcloud_instance_snapshots":            instancesnapshots.New(),
+			"nifcloud_instance_types":                instancetypes.New(),
+			"nifcloud_load_balancer_instance_health": loadbalancerinstancehealth.New(),
+			"nifcloud_load_balancer_ssl_policies":    loadbalancersslpolicies.New(),
+			"nifcloud_regions":                       regions.New(),
+			"nifcloud_security_group":                securitygroupdatasource.New(),
+			"nifcloud_security_group_rules":          securitygrouprules.New(),
+			"nifcloud_ssl_certificate":               sslcertificatedatasource.New(),
+			"nifcloud_storage_bucket":                bucketdatasource.New(),
+			"nifcloud_storage_object":                object.New(),
+			"nifcloud_storage_objects":               objects.New(),
+			"nifcloud_vpn_connection_device_config":  vpnconnectiondeviceconfig.New(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"nifcloud_customer_gateway":       customergateway.New(),
-			"nifcloud_db_instance":            dbinstance.New(),
-			"nifcloud_db_parameter_group":     dbparametergroup.New(),
-			"nifcloud_db_security_group":      dbsecuritygroup.New(),
-			"nifcloud_dhcp_config":            dhcpconfig.New(),
-			"nifcloud_dhcp_option":            dhcpoption.New(),
-			"nifcloud_dns_record":             record.New(),
-			"nifcloud_dns_zone":               zone.New(),
-			"nifcloud_elastic_ip":             elasticip.New(),
-			"nifcloud_elb":                    elb.New(),
-			"nifcloud_elb_listener":           elblistener.New(),
-			"nifcloud_ess_domain_dkim":        domaindkim.New(),
-			"nifcloud_ess_domain_identity":    domainidentity.New(),
-			"nifcloud_ess_email_identity":     emailidentity.New(),
-			"nifcloud_instance":               instance.New(),
-			"nifcloud_key_pair":               keypair.New(),
-			"nifcloud_nas_instance":           nasinstance.New(),
-			"nifcloud_nas_security_group":     nassecuritygroup.New(),
-			"nifcloud_nat_table":              nattable.New(),
-			"nifcloud_network_interface":      networkinterface.New(),
-			"nifcloud_load_balancer":          loadbalancer.New(),
-			"nifcloud_load_balancer_listener": loadbalancerlistener.New(),
-			"nifcloud_private_lan":            privatelan.New(),
-			"nifcloud_router":                 router.New(),
-			"nifcloud_route_table":            routetable.New(),
-			"nifcloud_security_group":         securitygroup.New(),
-			"nifcloud_security_group_rule":    securitygrouprule.New(),
-			"nifcloud_ssl_certificate":        sslcertificate.New(),
-			"nifcloud_volume":                 volume.New(),
-			"nifcloud_vpn_connection":         vpnconnection.New(),
-			"nifcloud_vpn_gateway":            vpngateway.New(),
-			"nifcloud_web_proxy":              webproxy.New(),
-			"nifcloud_hatoba_firewall_group":  firewallgroup.New(),
-			"nifcloud_hatoba_cluster":         cluster.New(),
-			"nifcloud_separate_instance_rule": separateinstancerule.New(),
-			"nifcloud_storage_bucket":         bucket.New(),
+			"nifcloud_alarm":                             alarm.New(),
+			"nifcloud_auto_scaling_group":                autoscalinggroup.New(),
+			"nifcloud_customer_gateway":                  customergateway.New(),
+			"nifcloud_db_instance":                       dbinstance.New(),
+			"nifcloud_db_parameter_group":                dbparametergroup.New(),
+			"nifcloud_db_security_group":                 dbsecuritygroup.New(),
+			"nifcloud_dhcp_config":                       dhcpconfig.New(),
+			"nifcloud_dhcp_option":                       dhcpoption.New(),
+			"nifcloud_dns_record":                        record.New(),
+			"nifcloud_dns_zone":                          zone.New(),
+			"nifcloud_elastic_ip":                        elasticip.New(),
+			"nifcloud_elastic_ip_association":            elasticipassociation.New(),
+			"nifcloud_elb":                               elb.New(),
+			"nifcloud_elb_instance_attachment":           elbinstanceattachment.New(),
+			"nifcloud_elb_listener":                      elblistener.New(),
+			"nifcloud_ess_domain_dkim":                   domaindkim.New(),
+			"nifcloud_ess_domain_identity":               domainidentity.New(),
+			"nifcloud_ess_email_identity":                emailidentity.New(),
+			"nifcloud_image":                             image.New(),
+			"nifcloud_instance":                          instance.New(),
+			"nifcloud_instance_backup_rule":              instancebackuprule.New(),
+			"nifcloud_instance_snapshot":                 instancesnapshot.New(),
+			"nifcloud_key_pair":                          keypair.New(),
+			"nifcloud_nas_instance":                      nasinstance.New(),
+			"nifcloud_nas_security_group":                nassecuritygroup.New(),
+			"nifcloud_nat_rule":                          natrule.New(),
+			"nifcloud_nat_table":                         nattable.New(),
+			"nifcloud_nat_table_association":             nattableassociation.New(),
+			"nifcloud_network_interface":                 networkinterface.New(),
+			"nifcloud_load_balancer":                     loadbalancer.New(),
+			"nifcloud_load_balancer_filter":              loadbalancerfilter.New(),
+			"nifcloud_load_balancer_instance_attachment": loadbalancerinstanceattachment.New(),
+			"nifcloud_load_balancer_listener":            loadbalancerlistener.New(),
+			"nifcloud_multi_ip_address_group":            multiipaddressgroup.New(),
+			"nifcloud_private_lan":                       privatelan.New(),
+			"nifcloud_route":                             route.New(),
+			"nifcloud_router":                            router.New(),
+			"nifcloud_router_network_interface":          routernetworkinterface.New(),
+			"nifcloud_route_table":                       routetable.New(),
+			"nifcloud_route_table_association":           routetableassociation.New(),
+			"nifcloud_security_group":                    securitygroup.New(),
+			"nifcloud_security_group_rule":               securitygrouprule.New(),
+			"nifcloud_ssl_certificate":                   sslcertificate.New(),
+			"nifcloud_volume":                            volume.New(),
+			"nifcloud_volume_attachment":                 volumeattachment.New(),
+			"nifcloud_vpn_connection":                    vpnconnection.New(),
+			"nifcloud_vpn_gateway":                       vpngateway.New(),
+			"nifcloud_web_proxy":                         webproxy.New(),
+			"nifcloud_hatoba_firewall_group":             firewallgroup.New(),
+			"nifcloud_hatoba_cluster":                    cluster.New(),
+			"nifcloud_separate_instance_rule":            separateinstancerule.New(),
+			"nifcloud_storage_bucket":                    bucket.New(),
 		},
 	}
 }
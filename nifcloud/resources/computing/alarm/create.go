@@ -0,0 +1,23 @@
+package alarm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandNiftyCreateAlarmInput(d)
+	svc := meta.(*client.Client).Computing
+
+	if _, err := svc.NiftyCreateAlarm(ctx, input); err != nil {
+		return diag.FromErr(fmt.Errorf("failed creating alarm: %s", err))
+	}
+
+	d.SetId(d.Get("rule_name").(string))
+
+	return read(ctx, d, meta)
+}
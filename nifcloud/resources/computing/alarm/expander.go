@@ -0,0 +1,127 @@
+package alarm
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandNiftyCreateAlarmInput(d *schema.ResourceData) *computing.NiftyCreateAlarmInput {
+	input := &computing.NiftyCreateAlarmInput{
+		RuleName:       nifcloud.String(d.Get("rule_name").(string)),
+		FunctionName:   types.FunctionNameOfNiftyCreateAlarmRequest(d.Get("function_name").(string)),
+		AlarmCondition: types.AlarmConditionOfNiftyCreateAlarmRequest(d.Get("alarm_condition").(string)),
+		Description:    nifcloud.String(d.Get("description").(string)),
+		EmailAddress:   expandStringSet(d.Get("email_address").(*schema.Set)),
+		InstanceId:     expandStringSet(d.Get("instance_id").(*schema.Set)),
+		Partition:      expandStringSet(d.Get("partition").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.Zone = nifcloud.String(v.(string))
+	}
+
+	for _, r := range d.Get("rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+		input.Rule = append(input.Rule, types.RequestRule{
+			DataType:            nifcloud.String(rule["data_type"].(string)),
+			Threshold:           nifcloud.Float64(rule["threshold"].(float64)),
+			UpperLowerCondition: types.UpperLowerConditionOfRuleForNiftyCreateAlarm(rule["upper_lower_condition"].(string)),
+			BreachDuration:      nifcloud.Int32(int32(rule["breach_duration"].(int))),
+		})
+	}
+
+	for _, lb := range d.Get("load_balancer").(*schema.Set).List() {
+		l := lb.(map[string]interface{})
+		input.LoadBalancerName = append(input.LoadBalancerName, l["load_balancer_name"].(string))
+		input.LoadBalancerPort = append(input.LoadBalancerPort, int32(l["load_balancer_port"].(int)))
+	}
+
+	for _, elb := range d.Get("elb").(*schema.Set).List() {
+		e := elb.(map[string]interface{})
+		input.ElasticLoadBalancerName = append(input.ElasticLoadBalancerName, e["elb_name"].(string))
+		input.ElasticLoadBalancerPort = append(input.ElasticLoadBalancerPort, int32(e["elb_port"].(int)))
+		input.ElasticLoadBalancerProtocol = append(input.ElasticLoadBalancerProtocol, e["elb_protocol"].(string))
+	}
+
+	return input
+}
+
+func expandNiftyUpdateAlarmInput(d *schema.ResourceData) *computing.NiftyUpdateAlarmInput {
+	input := &computing.NiftyUpdateAlarmInput{
+		RuleName:       nifcloud.String(d.Id()),
+		FunctionName:   types.FunctionNameOfNiftyUpdateAlarmRequest(d.Get("function_name").(string)),
+		AlarmCondition: types.AlarmConditionOfNiftyUpdateAlarmRequest(d.Get("alarm_condition").(string)),
+		Description:    nifcloud.String(d.Get("description").(string)),
+		EmailAddress:   expandStringSet(d.Get("email_address").(*schema.Set)),
+		InstanceId:     expandStringSet(d.Get("instance_id").(*schema.Set)),
+		Partition:      expandStringSet(d.Get("partition").(*schema.Set)),
+	}
+
+	if d.Get("rule_name").(string) != d.Id() {
+		input.RuleNameUpdate = nifcloud.String(d.Get("rule_name").(string))
+	}
+
+	for _, r := range d.Get("rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+		input.Rule = append(input.Rule, types.RequestRuleOfNiftyUpdateAlarm{
+			DataType:            nifcloud.String(rule["data_type"].(string)),
+			Threshold:           nifcloud.Float64(rule["threshold"].(float64)),
+			UpperLowerCondition: types.UpperLowerConditionOfRuleForNiftyUpdateAlarm(rule["upper_lower_condition"].(string)),
+			BreachDuration:      nifcloud.Int32(int32(rule["breach_duration"].(int))),
+		})
+	}
+
+	for _, lb := range d.Get("load_balancer").(*schema.Set).List() {
+		l := lb.(map[string]interface{})
+		input.LoadBalancerName = append(input.LoadBalancerName, l["load_balancer_name"].(string))
+		input.LoadBalancerPort = append(input.LoadBalancerPort, int32(l["load_balancer_port"].(int)))
+	}
+
+	for _, elb := range d.Get("elb").(*schema.Set).List() {
+		e := elb.(map[string]interface{})
+		input.ElasticLoadBalancerName = append(input.ElasticLoadBalancerName, e["elb_name"].(string))
+		input.ElasticLoadBalancerPort = append(input.ElasticLoadBalancerPort, int32(e["elb_port"].(int)))
+		input.ElasticLoadBalancerProtocol = append(input.ElasticLoadBalancerProtocol, e["elb_protocol"].(string))
+	}
+
+	return input
+}
+
+func expandStringSet(set *schema.Set) []string {
+	if set.Len() == 0 {
+		return nil
+	}
+
+	result := make([]string, set.Len())
+	for i, v := range set.List() {
+		result[i] = v.(string)
+	}
+	return result
+}
+
+func expandNiftyDescribeAlarmsInput(d *schema.ResourceData) *computing.NiftyDescribeAlarmsInput {
+	// function_name is unknown just after import, so all rules are listed and
+	// the matching one is picked up by the flattener.
+	functionName := d.Get("function_name").(string)
+	if functionName == "" {
+		return &computing.NiftyDescribeAlarmsInput{}
+	}
+
+	return &computing.NiftyDescribeAlarmsInput{
+		Rule: []types.RequestRuleOfNiftyDescribeAlarms{
+			{
+				FunctionName: types.FunctionNameOfRuleForNiftyDescribeAlarms(functionName),
+				RuleName:     nifcloud.String(d.Id()),
+			},
+		},
+	}
+}
+
+func expandNiftyDeleteAlarmInput(d *schema.ResourceData) *computing.NiftyDeleteAlarmInput {
+	return &computing.NiftyDeleteAlarmInput{
+		FunctionName: types.FunctionNameOfNiftyDeleteAlarmRequest(d.Get("function_name").(string)),
+		RuleName:     nifcloud.String(d.Id()),
+	}
+}
@@ -0,0 +1,251 @@
+package alarm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandNiftyCreateAlarmInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"rule_name":       "test_rule_name",
+		"function_name":   "Server",
+		"alarm_condition": "and",
+		"description":     "test_description",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"data_type":             "CPU",
+				"threshold":             80.5,
+				"upper_lower_condition": "upper",
+				"breach_duration":       5,
+			},
+		},
+		"email_address":     []interface{}{"test@example.com"},
+		"instance_id":       []interface{}{"test_instance_id"},
+		"availability_zone": "test_availability_zone",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyCreateAlarmInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyCreateAlarmInput{
+				RuleName:       nifcloud.String("test_rule_name"),
+				FunctionName:   types.FunctionNameOfNiftyCreateAlarmRequestServer,
+				AlarmCondition: types.AlarmConditionOfNiftyCreateAlarmRequestAnd,
+				Description:    nifcloud.String("test_description"),
+				EmailAddress:   []string{"test@example.com"},
+				InstanceId:     []string{"test_instance_id"},
+				Zone:           nifcloud.String("test_availability_zone"),
+				Rule: []types.RequestRule{
+					{
+						DataType:            nifcloud.String("CPU"),
+						Threshold:           nifcloud.Float64(80.5),
+						UpperLowerCondition: types.UpperLowerConditionOfRuleForNiftyCreateAlarmUpper,
+						BreachDuration:      nifcloud.Int32(5),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyCreateAlarmInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyCreateAlarmInputForLoadBalancer(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"rule_name":     "test_rule_name",
+		"function_name": "LoadBalancer",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"data_type":             "Network-Rx",
+				"threshold":             100.0,
+				"upper_lower_condition": "lower",
+				"breach_duration":       10,
+			},
+		},
+		"email_address": []interface{}{"test@example.com"},
+		"load_balancer": []interface{}{
+			map[string]interface{}{
+				"load_balancer_name": "test_load_balancer_name",
+				"load_balancer_port": 80,
+			},
+		},
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyCreateAlarmInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyCreateAlarmInput{
+				RuleName:         nifcloud.String("test_rule_name"),
+				FunctionName:     types.FunctionNameOfNiftyCreateAlarmRequestLoadBalancer,
+				AlarmCondition:   types.AlarmConditionOfNiftyCreateAlarmRequestOr,
+				Description:      nifcloud.String(""),
+				EmailAddress:     []string{"test@example.com"},
+				LoadBalancerName: []string{"test_load_balancer_name"},
+				LoadBalancerPort: []int32{80},
+				Rule: []types.RequestRule{
+					{
+						DataType:            nifcloud.String("Network-Rx"),
+						Threshold:           nifcloud.Float64(100),
+						UpperLowerCondition: types.UpperLowerConditionOfRuleForNiftyCreateAlarmLower,
+						BreachDuration:      nifcloud.Int32(10),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyCreateAlarmInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyUpdateAlarmInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"rule_name":       "test_rule_name_update",
+		"function_name":   "ElasticLoadBalancer",
+		"alarm_condition": "or",
+		"description":     "test_description",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"data_type":             "Network-Tx",
+				"threshold":             50.0,
+				"upper_lower_condition": "upper",
+				"breach_duration":       5,
+			},
+		},
+		"email_address": []interface{}{"test@example.com"},
+		"elb": []interface{}{
+			map[string]interface{}{
+				"elb_name":     "test_elb_name",
+				"elb_port":     80,
+				"elb_protocol": "HTTP",
+			},
+		},
+	})
+	rd.SetId("test_rule_name")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyUpdateAlarmInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyUpdateAlarmInput{
+				RuleName:                    nifcloud.String("test_rule_name"),
+				RuleNameUpdate:              nifcloud.String("test_rule_name_update"),
+				FunctionName:                types.FunctionNameOfNiftyUpdateAlarmRequestElasticLoadBalancer,
+				AlarmCondition:              types.AlarmConditionOfNiftyUpdateAlarmRequestOr,
+				Description:                 nifcloud.String("test_description"),
+				EmailAddress:                []string{"test@example.com"},
+				ElasticLoadBalancerName:     []string{"test_elb_name"},
+				ElasticLoadBalancerPort:     []int32{80},
+				ElasticLoadBalancerProtocol: []string{"HTTP"},
+				Rule: []types.RequestRuleOfNiftyUpdateAlarm{
+					{
+						DataType:            nifcloud.String("Network-Tx"),
+						Threshold:           nifcloud.Float64(50),
+						UpperLowerCondition: types.UpperLowerConditionOfRuleForNiftyUpdateAlarmUpper,
+						BreachDuration:      nifcloud.Int32(5),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyUpdateAlarmInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeAlarmsInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"function_name": "Server",
+	})
+	rd.SetId("test_rule_name")
+
+	importedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	importedRd.SetId("test_rule_name")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeAlarmsInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeAlarmsInput{
+				Rule: []types.RequestRuleOfNiftyDescribeAlarms{
+					{
+						FunctionName: types.FunctionNameOfRuleForNiftyDescribeAlarmsServer,
+						RuleName:     nifcloud.String("test_rule_name"),
+					},
+				},
+			},
+		},
+		{
+			name: "expands the resource data without function name",
+			args: importedRd,
+			want: &computing.NiftyDescribeAlarmsInput{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeAlarmsInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDeleteAlarmInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"function_name": "Server",
+	})
+	rd.SetId("test_rule_name")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDeleteAlarmInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDeleteAlarmInput{
+				FunctionName: types.FunctionNameOfNiftyDeleteAlarmRequestServer,
+				RuleName:     nifcloud.String("test_rule_name"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDeleteAlarmInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package alarm
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, res *computing.NiftyDescribeAlarmsOutput) error {
+	if res == nil || len(res.ReservationSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	var alarm *types.ReservationSetOfNiftyDescribeAlarms
+	for i := range res.ReservationSet {
+		if nifcloud.ToString(res.ReservationSet[i].RuleName) == d.Id() {
+			alarm = &res.ReservationSet[i]
+			break
+		}
+	}
+
+	if alarm == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("rule_name", alarm.RuleName); err != nil {
+		return err
+	}
+
+	if err := d.Set("function_name", alarm.FunctionName); err != nil {
+		return err
+	}
+
+	if err := d.Set("alarm_condition", alarm.AlarmCondition); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", alarm.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("availability_zone", alarm.Zone); err != nil {
+		return err
+	}
+
+	if err := d.Set("alarm_state", alarm.AlarmState); err != nil {
+		return err
+	}
+
+	var rules []map[string]interface{}
+	for _, r := range alarm.RuleSet {
+		rules = append(rules, map[string]interface{}{
+			"data_type":             nifcloud.ToString(r.DataType),
+			"threshold":             nifcloud.ToFloat64(r.Threshold),
+			"upper_lower_condition": nifcloud.ToString(r.UpperLowerCondition),
+			"breach_duration":       nifcloud.ToInt32(r.BreachDuration),
+		})
+	}
+	if err := d.Set("rule", rules); err != nil {
+		return err
+	}
+
+	var emailAddresses []string
+	for _, e := range alarm.EmailAddressSet {
+		emailAddresses = append(emailAddresses, nifcloud.ToString(e.EmailAddress))
+	}
+	if err := d.Set("email_address", emailAddresses); err != nil {
+		return err
+	}
+
+	// The response has only the resource names of the targets,
+	// so the ports and partitions are kept as configured.
+	var names []string
+	for _, t := range alarm.AlarmTargetsSet {
+		names = append(names, nifcloud.ToString(t.ResourceName))
+	}
+
+	switch nifcloud.ToString(alarm.FunctionName) {
+	case "Server", "DiskPartition":
+		if err := d.Set("instance_id", names); err != nil {
+			return err
+		}
+	case "LoadBalancer":
+		if err := d.Set("load_balancer", flattenTargets(d.Get("load_balancer").(*schema.Set), "load_balancer_name", names)); err != nil {
+			return err
+		}
+	case "ElasticLoadBalancer":
+		if err := d.Set("elb", flattenTargets(d.Get("elb").(*schema.Set), "elb_name", names)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flattenTargets returns the targets in the state whose names are in the response,
+// and a target without the port for each name which is not in the state.
+func flattenTargets(state *schema.Set, nameKey string, names []string) []map[string]interface{} {
+	var targets []map[string]interface{}
+	for _, name := range names {
+		found := false
+		for _, v := range state.List() {
+			t := v.(map[string]interface{})
+			if t[nameKey].(string) == name {
+				targets = append(targets, t)
+				found = true
+			}
+		}
+
+		if !found {
+			targets = append(targets, map[string]interface{}{nameKey: name})
+		}
+	}
+	return targets
+}
@@ -0,0 +1,210 @@
+package alarm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"rule_name":       "test_rule_name",
+		"function_name":   "Server",
+		"alarm_condition": "and",
+		"description":     "test_description",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"data_type":             "CPU",
+				"threshold":             80.5,
+				"upper_lower_condition": "upper",
+				"breach_duration":       5,
+			},
+		},
+		"email_address":     []interface{}{"test@example.com"},
+		"instance_id":       []interface{}{"test_instance_id"},
+		"availability_zone": "test_availability_zone",
+		"alarm_state":       "ok",
+	})
+	rd.SetId("test_rule_name")
+
+	lbRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"rule_name":     "test_rule_name",
+		"function_name": "LoadBalancer",
+		"load_balancer": []interface{}{
+			map[string]interface{}{
+				"load_balancer_name": "test_load_balancer_name",
+				"load_balancer_port": 80,
+			},
+		},
+	})
+	lbRd.SetId("test_rule_name")
+
+	wantLbRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"rule_name":       "test_rule_name",
+		"function_name":   "LoadBalancer",
+		"alarm_condition": "or",
+		"description":     "test_description",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"data_type":             "Request",
+				"threshold":             100.0,
+				"upper_lower_condition": "upper",
+				"breach_duration":       5,
+			},
+		},
+		"email_address":     []interface{}{"test@example.com"},
+		"availability_zone": "test_availability_zone",
+		"alarm_state":       "ok",
+		"load_balancer": []interface{}{
+			map[string]interface{}{
+				"load_balancer_name": "test_load_balancer_name",
+				"load_balancer_port": 80,
+			},
+			map[string]interface{}{
+				"load_balancer_name": "test_other_load_balancer_name",
+			},
+		},
+	})
+	wantLbRd.SetId("test_rule_name")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantNotMatchRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	wantNotMatchRd.SetId("test_rule_name")
+
+	type args struct {
+		res *computing.NiftyDescribeAlarmsOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeAlarmsOutput{
+					ReservationSet: []types.ReservationSetOfNiftyDescribeAlarms{
+						{
+							RuleName:       nifcloud.String("test_other_rule_name"),
+							FunctionName:   nifcloud.String("Server"),
+							AlarmCondition: nifcloud.String("or"),
+						},
+						{
+							RuleName:       nifcloud.String("test_rule_name"),
+							FunctionName:   nifcloud.String("Server"),
+							AlarmCondition: nifcloud.String("and"),
+							Description:    nifcloud.String("test_description"),
+							Zone:           nifcloud.String("test_availability_zone"),
+							AlarmState:     nifcloud.String("ok"),
+							RuleSet: []types.RuleSet{
+								{
+									DataType:            nifcloud.String("CPU"),
+									Threshold:           nifcloud.Float64(80.5),
+									UpperLowerCondition: nifcloud.String("upper"),
+									BreachDuration:      nifcloud.Int32(5),
+								},
+							},
+							EmailAddressSet: []types.EmailAddressSet{
+								{EmailAddress: nifcloud.String("test@example.com")},
+							},
+							AlarmTargetsSet: []types.AlarmTargetsSet{
+								{ResourceName: nifcloud.String("test_instance_id")},
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the load balancer targets keeping the ports in the state",
+			args: args{
+				d: lbRd,
+				res: &computing.NiftyDescribeAlarmsOutput{
+					ReservationSet: []types.ReservationSetOfNiftyDescribeAlarms{
+						{
+							RuleName:       nifcloud.String("test_rule_name"),
+							FunctionName:   nifcloud.String("LoadBalancer"),
+							AlarmCondition: nifcloud.String("or"),
+							Description:    nifcloud.String("test_description"),
+							Zone:           nifcloud.String("test_availability_zone"),
+							AlarmState:     nifcloud.String("ok"),
+							RuleSet: []types.RuleSet{
+								{
+									DataType:            nifcloud.String("Request"),
+									Threshold:           nifcloud.Float64(100),
+									UpperLowerCondition: nifcloud.String("upper"),
+									BreachDuration:      nifcloud.Int32(5),
+								},
+							},
+							EmailAddressSet: []types.EmailAddressSet{
+								{EmailAddress: nifcloud.String("test@example.com")},
+							},
+							AlarmTargetsSet: []types.AlarmTargetsSet{
+								{ResourceName: nifcloud.String("test_load_balancer_name")},
+								{ResourceName: nifcloud.String("test_other_load_balancer_name")},
+							},
+						},
+					},
+				},
+			},
+			want: wantLbRd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeAlarmsOutput{
+					ReservationSet: []types.ReservationSetOfNiftyDescribeAlarms{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when no rule matches",
+			args: args{
+				d: wantNotMatchRd,
+				res: &computing.NiftyDescribeAlarmsOutput{
+					ReservationSet: []types.ReservationSetOfNiftyDescribeAlarms{
+						{
+							RuleName: nifcloud.String("test_other_rule_name"),
+						},
+					},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
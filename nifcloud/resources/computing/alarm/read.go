@@ -0,0 +1,33 @@
+package alarm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandNiftyDescribeAlarmsInput(d)
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.NiftyDescribeAlarms(ctx, input)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.RuleName" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+	}
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package alarm
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Provides a monitoring alarm rule resource."
+
+// New returns the nifcloud_alarm resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"rule_name": {
+			Type:         schema.TypeString,
+			Description:  "The alarm rule name.",
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 15),
+		},
+		"function_name": {
+			Type:        schema.TypeString,
+			Description: "The type of the resources to monitor. (`Server`, `DiskPartition`, `LoadBalancer` or `ElasticLoadBalancer`). RDB alarms are not supported.",
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Server",
+				"DiskPartition",
+				"LoadBalancer",
+				"ElasticLoadBalancer",
+			}, false),
+		},
+		"alarm_condition": {
+			Type:         schema.TypeString,
+			Description:  "The condition to combine the rules. (`and` or `or`).",
+			Optional:     true,
+			Default:      "or",
+			ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+		},
+		"description": {
+			Type:             schema.TypeString,
+			Description:      "The alarm rule description.",
+			Optional:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(0, 40),
+		},
+		"rule": {
+			Type:        schema.TypeList,
+			Description: "The threshold rules.",
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"data_type": {
+						Type:        schema.TypeString,
+						Description: "The metric to monitor. e.g. `CPU`, `Disk`, `Network-Rx`.",
+						Required:    true,
+					},
+					"threshold": {
+						Type:        schema.TypeFloat,
+						Description: "The threshold of the metric.",
+						Required:    true,
+					},
+					"upper_lower_condition": {
+						Type:         schema.TypeString,
+						Description:  "Whether the alarm fires above or below the threshold. (`upper` or `lower`).",
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"upper", "lower"}, false),
+					},
+					"breach_duration": {
+						Type:        schema.TypeInt,
+						Description: "The duration in minutes that the threshold must be breached for.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"email_address": {
+			Type:        schema.TypeSet,
+			Description: "The e-mail addresses to notify.",
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"instance_id": {
+			Type:        schema.TypeSet,
+			Description: "The instance names to monitor. Used with the `Server` and `DiskPartition` functions.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"partition": {
+			Type:        schema.TypeSet,
+			Description: "The disk partitions to monitor. Used with the `DiskPartition` function.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"load_balancer": {
+			Type:        schema.TypeSet,
+			Description: "The load balancers to monitor. Used with the `LoadBalancer` function.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"load_balancer_name": {
+						Type:        schema.TypeString,
+						Description: "The load balancer name.",
+						Required:    true,
+					},
+					"load_balancer_port": {
+						Type:         schema.TypeInt,
+						Description:  "The port on which the load balancer is listening.",
+						Required:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+		"elb": {
+			Type:        schema.TypeSet,
+			Description: "The elastic load balancers to monitor. Used with the `ElasticLoadBalancer` function.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"elb_name": {
+						Type:        schema.TypeString,
+						Description: "The elastic load balancer name.",
+						Required:    true,
+					},
+					"elb_port": {
+						Type:         schema.TypeInt,
+						Description:  "The port on which the elastic load balancer is listening.",
+						Required:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+					"elb_protocol": {
+						Type:        schema.TypeString,
+						Description: "The protocol of the elastic load balancer listener.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone.",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+		},
+		"alarm_state": {
+			Type:        schema.TypeString,
+			Description: "The state of the alarm.",
+			Computed:    true,
+		},
+	}
+}
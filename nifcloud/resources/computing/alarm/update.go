@@ -0,0 +1,25 @@
+package alarm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	// NiftyUpdateAlarm replaces the rules, targets and e-mail addresses as a whole,
+	// so all of them are sent whichever attribute has changed.
+	input := expandNiftyUpdateAlarmInput(d)
+	if _, err := svc.NiftyUpdateAlarm(ctx, input); err != nil {
+		return diag.FromErr(fmt.Errorf("failed updating alarm: %s", err))
+	}
+
+	d.SetId(d.Get("rule_name").(string))
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,24 @@
+package autoscalinggroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandNiftyCreateAutoScalingGroupInput(d)
+
+	svc := meta.(*client.Client).Computing
+	_, err := svc.NiftyCreateAutoScalingGroup(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed creating auto scaling group: %s", err))
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,224 @@
+package autoscalinggroup
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+var weekdays = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+func expandNiftyCreateAutoScalingGroupInput(d *schema.ResourceData) *computing.NiftyCreateAutoScalingGroupInput {
+	input := &computing.NiftyCreateAutoScalingGroupInput{
+		AutoScalingGroupName: nifcloud.String(d.Get("name").(string)),
+		ImageId:              nifcloud.String(d.Get("image_id").(string)),
+		InstanceType:         types.InstanceTypeOfNiftyCreateAutoScalingGroupRequest(d.Get("instance_type").(string)),
+		SecurityGroup:        expandStringSet(d.Get("security_group").(*schema.Set)),
+		MinSize:              nifcloud.Int32(int32(d.Get("min_size").(int))),
+		MaxSize:              nifcloud.Int32(int32(d.Get("max_size").(int))),
+		ChangeInCapacity:     nifcloud.Int32(int32(d.Get("change_in_capacity").(int))),
+		ScaleoutCondition:    types.ScaleoutConditionOfNiftyCreateAutoScalingGroupRequest(d.Get("scaleout_condition").(string)),
+		Description:          nifcloud.String(d.Get("description").(string)),
+	}
+
+	if v, ok := d.GetOk("scaleout"); ok {
+		input.Scaleout = nifcloud.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("default_cooldown"); ok {
+		input.DefaultCooldown = nifcloud.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("instance_lifecycle_limit"); ok {
+		input.InstanceLifecycleLimit = nifcloud.Int32(int32(v.(int)))
+	}
+
+	for _, lb := range d.Get("load_balancer").(*schema.Set).List() {
+		l := lb.(map[string]interface{})
+		input.LoadBalancers = append(input.LoadBalancers, types.RequestLoadBalancersOfNiftyCreateAutoScalingGroup{
+			Name:             nifcloud.String(l["load_balancer_name"].(string)),
+			LoadBalancerPort: nifcloud.Int32(int32(l["load_balancer_port"].(int))),
+			InstancePort:     nifcloud.Int32(int32(l["instance_port"].(int))),
+		})
+	}
+
+	for _, tr := range d.Get("trigger").([]interface{}) {
+		t := tr.(map[string]interface{})
+		input.ScalingTrigger = append(input.ScalingTrigger, types.RequestScalingTrigger{
+			Resource:       types.ResourceOfScalingTriggerForNiftyCreateAutoScalingGroup(t["resource"].(string)),
+			UpperThreshold: nifcloud.Float64(t["upper_threshold"].(float64)),
+			BreachDuration: nifcloud.Int32(int32(t["breach_duration"].(int))),
+		})
+	}
+
+	for _, sc := range d.Get("schedule").([]interface{}) {
+		s := sc.(map[string]interface{})
+		schedule := types.RequestScalingSchedule{
+			RequestDDay:     expandRequestDDay(s),
+			RequestMonth:    expandRequestMonth(s),
+			RequestTimeZone: expandRequestTimeZone(s),
+		}
+
+		if days := expandDays(s["days"].(*schema.Set)); days != nil {
+			schedule.RequestDay = &types.RequestDay{
+				SetSunday:    types.SetSundayOfScalingScheduleForNiftyCreateAutoScalingGroup(days["sunday"]),
+				SetMonday:    types.SetMondayOfScalingScheduleForNiftyCreateAutoScalingGroup(days["monday"]),
+				SetTuesday:   types.SetTuesdayOfScalingScheduleForNiftyCreateAutoScalingGroup(days["tuesday"]),
+				SetWednesday: types.SetWednesdayOfScalingScheduleForNiftyCreateAutoScalingGroup(days["wednesday"]),
+				SetThursday:  types.SetThursdayOfScalingScheduleForNiftyCreateAutoScalingGroup(days["thursday"]),
+				SetFriday:    types.SetFridayOfScalingScheduleForNiftyCreateAutoScalingGroup(days["friday"]),
+				SetSaturday:  types.SetSaturdayOfScalingScheduleForNiftyCreateAutoScalingGroup(days["saturday"]),
+			}
+		}
+		input.ScalingSchedule = append(input.ScalingSchedule, schedule)
+	}
+
+	return input
+}
+
+func expandNiftyUpdateAutoScalingGroupInput(d *schema.ResourceData) *computing.NiftyUpdateAutoScalingGroupInput {
+	input := &computing.NiftyUpdateAutoScalingGroupInput{
+		AutoScalingGroupName: nifcloud.String(d.Id()),
+		ImageId:              nifcloud.String(d.Get("image_id").(string)),
+		InstanceType:         types.InstanceTypeOfNiftyUpdateAutoScalingGroupRequest(d.Get("instance_type").(string)),
+		SecurityGroup:        expandStringSet(d.Get("security_group").(*schema.Set)),
+		MinSize:              nifcloud.Int32(int32(d.Get("min_size").(int))),
+		MaxSize:              nifcloud.Int32(int32(d.Get("max_size").(int))),
+		ChangeInCapacity:     nifcloud.Int32(int32(d.Get("change_in_capacity").(int))),
+		ScaleoutCondition:    types.ScaleoutConditionOfNiftyUpdateAutoScalingGroupRequest(d.Get("scaleout_condition").(string)),
+		Description:          nifcloud.String(d.Get("description").(string)),
+	}
+
+	if d.Get("name").(string) != d.Id() {
+		input.AutoScalingGroupNameUpdate = nifcloud.String(d.Get("name").(string))
+	}
+
+	if v, ok := d.GetOk("scaleout"); ok {
+		input.Scaleout = nifcloud.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("default_cooldown"); ok {
+		input.DefaultCooldown = nifcloud.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("instance_lifecycle_limit"); ok {
+		input.InstanceLifecycleLimit = nifcloud.Int32(int32(v.(int)))
+	}
+
+	for _, lb := range d.Get("load_balancer").(*schema.Set).List() {
+		l := lb.(map[string]interface{})
+		input.LoadBalancers = append(input.LoadBalancers, types.RequestLoadBalancersOfNiftyUpdateAutoScalingGroup{
+			Name:             nifcloud.String(l["load_balancer_name"].(string)),
+			LoadBalancerPort: nifcloud.Int32(int32(l["load_balancer_port"].(int))),
+			InstancePort:     nifcloud.Int32(int32(l["instance_port"].(int))),
+		})
+	}
+
+	for _, tr := range d.Get("trigger").([]interface{}) {
+		t := tr.(map[string]interface{})
+		input.ScalingTrigger = append(input.ScalingTrigger, types.RequestScalingTriggerOfNiftyUpdateAutoScalingGroup{
+			Resource:       types.ResourceOfScalingTriggerForNiftyUpdateAutoScalingGroup(t["resource"].(string)),
+			UpperThreshold: nifcloud.Float64(t["upper_threshold"].(float64)),
+			BreachDuration: nifcloud.Int32(int32(t["breach_duration"].(int))),
+		})
+	}
+
+	for _, sc := range d.Get("schedule").([]interface{}) {
+		s := sc.(map[string]interface{})
+		schedule := types.RequestScalingScheduleOfNiftyUpdateAutoScalingGroup{
+			RequestDDay:     expandRequestDDay(s),
+			RequestMonth:    expandRequestMonth(s),
+			RequestTimeZone: expandRequestTimeZone(s),
+		}
+
+		if days := expandDays(s["days"].(*schema.Set)); days != nil {
+			schedule.RequestDay = &types.RequestDayOfNiftyUpdateAutoScalingGroup{
+				SetSunday:    types.SetSundayOfScalingScheduleForNiftyUpdateAutoScalingGroup(days["sunday"]),
+				SetMonday:    types.SetMondayOfScalingScheduleForNiftyUpdateAutoScalingGroup(days["monday"]),
+				SetTuesday:   types.SetTuesdayOfScalingScheduleForNiftyUpdateAutoScalingGroup(days["tuesday"]),
+				SetWednesday: types.SetWednesdayOfScalingScheduleForNiftyUpdateAutoScalingGroup(days["wednesday"]),
+				SetThursday:  types.SetThursdayOfScalingScheduleForNiftyUpdateAutoScalingGroup(days["thursday"]),
+				SetFriday:    types.SetFridayOfScalingScheduleForNiftyUpdateAutoScalingGroup(days["friday"]),
+				SetSaturday:  types.SetSaturdayOfScalingScheduleForNiftyUpdateAutoScalingGroup(days["saturday"]),
+			}
+		}
+		input.ScalingSchedule = append(input.ScalingSchedule, schedule)
+	}
+
+	return input
+}
+
+func expandStringSet(set *schema.Set) []string {
+	if set.Len() == 0 {
+		return nil
+	}
+
+	result := make([]string, set.Len())
+	for i, v := range set.List() {
+		result[i] = v.(string)
+	}
+	return result
+}
+
+// expandDays converts the set of weekday names into the "1" (enabled) / "0" (disabled) flags of the API.
+func expandDays(set *schema.Set) map[string]string {
+	if set.Len() == 0 {
+		return nil
+	}
+
+	days := make(map[string]string, len(weekdays))
+	for _, w := range weekdays {
+		if set.Contains(w) {
+			days[w] = "1"
+		} else {
+			days[w] = "0"
+		}
+	}
+	return days
+}
+
+func expandRequestDDay(s map[string]interface{}) *types.RequestDDay {
+	if s["starting_dday"].(string) == "" && s["ending_dday"].(string) == "" {
+		return nil
+	}
+
+	return &types.RequestDDay{
+		StartingDDay: nifcloud.String(s["starting_dday"].(string)),
+		EndingDDay:   nifcloud.String(s["ending_dday"].(string)),
+	}
+}
+
+func expandRequestMonth(s map[string]interface{}) *types.RequestMonth {
+	if s["starting_month"].(string) == "" && s["ending_month"].(string) == "" {
+		return nil
+	}
+
+	return &types.RequestMonth{
+		StartingMonth: nifcloud.String(s["starting_month"].(string)),
+		EndingMonth:   nifcloud.String(s["ending_month"].(string)),
+	}
+}
+
+func expandRequestTimeZone(s map[string]interface{}) *types.RequestTimeZone {
+	if s["starting_time_zone"].(string) == "" && s["ending_time_zone"].(string) == "" {
+		return nil
+	}
+
+	return &types.RequestTimeZone{
+		StartingTimeZone: nifcloud.String(s["starting_time_zone"].(string)),
+		EndingTimeZone:   nifcloud.String(s["ending_time_zone"].(string)),
+	}
+}
+
+func expandNiftyDescribeAutoScalingGroupsInput(d *schema.ResourceData) *computing.NiftyDescribeAutoScalingGroupsInput {
+	return &computing.NiftyDescribeAutoScalingGroupsInput{
+		AutoScalingGroupName: []string{d.Id()},
+	}
+}
+
+func expandNiftyDeleteAutoScalingGroupInput(d *schema.ResourceData) *computing.NiftyDeleteAutoScalingGroupInput {
+	return &computing.NiftyDeleteAutoScalingGroupInput{
+		AutoScalingGroupName: nifcloud.String(d.Id()),
+	}
+}
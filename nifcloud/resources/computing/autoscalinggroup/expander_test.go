@@ -0,0 +1,220 @@
+package autoscalinggroup
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandNiftyCreateAutoScalingGroupInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name":                     "test_name",
+		"image_id":                 "test_image_id",
+		"instance_type":            "mini",
+		"security_group":           []interface{}{"test_security_group"},
+		"min_size":                 1,
+		"max_size":                 3,
+		"change_in_capacity":       1,
+		"scaleout":                 2,
+		"scaleout_condition":       "and",
+		"default_cooldown":         300,
+		"instance_lifecycle_limit": 10,
+		"description":              "test_description",
+		"load_balancer": []interface{}{
+			map[string]interface{}{
+				"load_balancer_name": "test_load_balancer_name",
+				"load_balancer_port": 80,
+				"instance_port":      8080,
+			},
+		},
+		"trigger": []interface{}{
+			map[string]interface{}{
+				"resource":        "Server-cpu",
+				"upper_threshold": 80.5,
+				"breach_duration": 5,
+			},
+		},
+		"schedule": []interface{}{
+			map[string]interface{}{
+				"days":               []interface{}{"monday", "friday"},
+				"starting_time_zone": "9",
+				"ending_time_zone":   "18",
+			},
+		},
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyCreateAutoScalingGroupInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyCreateAutoScalingGroupInput{
+				AutoScalingGroupName:   nifcloud.String("test_name"),
+				ImageId:                nifcloud.String("test_image_id"),
+				InstanceType:           types.InstanceTypeOfNiftyCreateAutoScalingGroupRequestMini,
+				SecurityGroup:          []string{"test_security_group"},
+				MinSize:                nifcloud.Int32(1),
+				MaxSize:                nifcloud.Int32(3),
+				ChangeInCapacity:       nifcloud.Int32(1),
+				Scaleout:               nifcloud.Int32(2),
+				ScaleoutCondition:      types.ScaleoutConditionOfNiftyCreateAutoScalingGroupRequestAnd,
+				DefaultCooldown:        nifcloud.Int32(300),
+				InstanceLifecycleLimit: nifcloud.Int32(10),
+				Description:            nifcloud.String("test_description"),
+				LoadBalancers: []types.RequestLoadBalancersOfNiftyCreateAutoScalingGroup{
+					{
+						Name:             nifcloud.String("test_load_balancer_name"),
+						LoadBalancerPort: nifcloud.Int32(80),
+						InstancePort:     nifcloud.Int32(8080),
+					},
+				},
+				ScalingTrigger: []types.RequestScalingTrigger{
+					{
+						Resource:       types.ResourceOfScalingTriggerForNiftyCreateAutoScalingGroupServerCpu,
+						UpperThreshold: nifcloud.Float64(80.5),
+						BreachDuration: nifcloud.Int32(5),
+					},
+				},
+				ScalingSchedule: []types.RequestScalingSchedule{
+					{
+						RequestDay: &types.RequestDay{
+							SetSunday:    types.SetSundayOfScalingScheduleForNiftyCreateAutoScalingGroupDisabled,
+							SetMonday:    types.SetMondayOfScalingScheduleForNiftyCreateAutoScalingGroupEnabled,
+							SetTuesday:   types.SetTuesdayOfScalingScheduleForNiftyCreateAutoScalingGroupDisabled,
+							SetWednesday: types.SetWednesdayOfScalingScheduleForNiftyCreateAutoScalingGroupDisabled,
+							SetThursday:  types.SetThursdayOfScalingScheduleForNiftyCreateAutoScalingGroupDisabled,
+							SetFriday:    types.SetFridayOfScalingScheduleForNiftyCreateAutoScalingGroupEnabled,
+							SetSaturday:  types.SetSaturdayOfScalingScheduleForNiftyCreateAutoScalingGroupDisabled,
+						},
+						RequestTimeZone: &types.RequestTimeZone{
+							StartingTimeZone: nifcloud.String("9"),
+							EndingTimeZone:   nifcloud.String("18"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyCreateAutoScalingGroupInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyUpdateAutoScalingGroupInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name":               "test_name",
+		"image_id":           "test_image_id",
+		"instance_type":      "mini",
+		"min_size":           1,
+		"max_size":           3,
+		"change_in_capacity": 1,
+		"scaleout_condition": "or",
+		"description":        "test_description",
+		"trigger": []interface{}{
+			map[string]interface{}{
+				"resource":        "Server-memory",
+				"upper_threshold": 70.0,
+				"breach_duration": 3,
+			},
+		},
+	})
+	rd.SetId("test_name")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyUpdateAutoScalingGroupInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyUpdateAutoScalingGroupInput{
+				AutoScalingGroupName: nifcloud.String("test_name"),
+				ImageId:              nifcloud.String("test_image_id"),
+				InstanceType:         types.InstanceTypeOfNiftyUpdateAutoScalingGroupRequestMini,
+				MinSize:              nifcloud.Int32(1),
+				MaxSize:              nifcloud.Int32(3),
+				ChangeInCapacity:     nifcloud.Int32(1),
+				ScaleoutCondition:    types.ScaleoutConditionOfNiftyUpdateAutoScalingGroupRequestOr,
+				Description:          nifcloud.String("test_description"),
+				ScalingTrigger: []types.RequestScalingTriggerOfNiftyUpdateAutoScalingGroup{
+					{
+						Resource:       types.ResourceOfScalingTriggerForNiftyUpdateAutoScalingGroupServerMemory,
+						UpperThreshold: nifcloud.Float64(70.0),
+						BreachDuration: nifcloud.Int32(3),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyUpdateAutoScalingGroupInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeAutoScalingGroupsInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_name")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeAutoScalingGroupsInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeAutoScalingGroupsInput{
+				AutoScalingGroupName: []string{"test_name"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeAutoScalingGroupsInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDeleteAutoScalingGroupInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_name")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDeleteAutoScalingGroupInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDeleteAutoScalingGroupInput{
+				AutoScalingGroupName: nifcloud.String("test_name"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDeleteAutoScalingGroupInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package autoscalinggroup
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, res *computing.NiftyDescribeAutoScalingGroupsOutput) error {
+	if res == nil || len(res.AutoScalingReservationSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	group := res.AutoScalingReservationSet[0]
+
+	if err := d.Set("name", group.AutoScalingGroupName); err != nil {
+		return err
+	}
+
+	if err := d.Set("image_id", group.ImageId); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_type", group.InstanceType); err != nil {
+		return err
+	}
+
+	var securityGroups []string
+	for _, g := range group.GroupSet {
+		securityGroups = append(securityGroups, nifcloud.ToString(g.GroupId))
+	}
+	if err := d.Set("security_group", securityGroups); err != nil {
+		return err
+	}
+
+	if err := d.Set("min_size", group.MinSize); err != nil {
+		return err
+	}
+
+	if err := d.Set("max_size", group.MaxSize); err != nil {
+		return err
+	}
+
+	if err := d.Set("change_in_capacity", group.ChangeInCapacity); err != nil {
+		return err
+	}
+
+	if err := d.Set("scaleout", group.Scaleout); err != nil {
+		return err
+	}
+
+	if err := d.Set("scaleout_condition", group.ScaleoutCondition); err != nil {
+		return err
+	}
+
+	if err := d.Set("default_cooldown", group.DefaultCooldown); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_lifecycle_limit", group.InstanceLifecycleLimit); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", group.Description); err != nil {
+		return err
+	}
+
+	var loadBalancers []map[string]interface{}
+	for _, lb := range group.LoadBalancing {
+		loadBalancers = append(loadBalancers, map[string]interface{}{
+			"load_balancer_name": nifcloud.ToString(lb.LoadBalancerName),
+			"load_balancer_port": nifcloud.ToInt32(lb.LoadBalancerPort),
+			"instance_port":      nifcloud.ToInt32(lb.InstancePort),
+		})
+	}
+	if err := d.Set("load_balancer", loadBalancers); err != nil {
+		return err
+	}
+
+	var triggers []map[string]interface{}
+	for _, t := range group.TriggerSet {
+		triggers = append(triggers, map[string]interface{}{
+			"resource":        nifcloud.ToString(t.Resource),
+			"upper_threshold": nifcloud.ToFloat64(t.UpperThreshold),
+			"breach_duration": nifcloud.ToInt32(t.BreachDuration),
+		})
+	}
+	if err := d.Set("trigger", triggers); err != nil {
+		return err
+	}
+
+	var schedules []map[string]interface{}
+	for _, s := range group.ScheduleSet {
+		schedules = append(schedules, flattenSchedule(s))
+	}
+	if err := d.Set("schedule", schedules); err != nil {
+		return err
+	}
+
+	if group.Placement != nil {
+		if err := d.Set("availability_zone", group.Placement.AvailabilityZone); err != nil {
+			return err
+		}
+	}
+
+	var instances []string
+	for _, i := range group.InstancesSet {
+		instances = append(instances, nifcloud.ToString(i.InstanceId))
+	}
+	if err := d.Set("instances", instances); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenSchedule(s types.ScheduleSet) map[string]interface{} {
+	schedule := map[string]interface{}{}
+
+	if s.Day != nil {
+		flags := map[string]*string{
+			"sunday":    s.Day.SetSunday,
+			"monday":    s.Day.SetMonday,
+			"tuesday":   s.Day.SetTuesday,
+			"wednesday": s.Day.SetWednesday,
+			"thursday":  s.Day.SetThursday,
+			"friday":    s.Day.SetFriday,
+			"saturday":  s.Day.SetSaturday,
+		}
+
+		var days []interface{}
+		for _, w := range weekdays {
+			if nifcloud.ToString(flags[w]) == "1" {
+				days = append(days, w)
+			}
+		}
+		schedule["days"] = days
+	}
+
+	if s.DDay != nil {
+		schedule["starting_dday"] = nifcloud.ToString(s.DDay.StartingDDay)
+		schedule["ending_dday"] = nifcloud.ToString(s.DDay.EndingDDay)
+	}
+
+	if s.Month != nil {
+		schedule["starting_month"] = nifcloud.ToString(s.Month.StartingMonth)
+		schedule["ending_month"] = nifcloud.ToString(s.Month.EndingMonth)
+	}
+
+	if s.TimeZone != nil {
+		schedule["starting_time_zone"] = nifcloud.ToString(s.TimeZone.StartingTimeZone)
+		schedule["ending_time_zone"] = nifcloud.ToString(s.TimeZone.EndingTimeZone)
+	}
+
+	return schedule
+}
@@ -0,0 +1,162 @@
+package autoscalinggroup
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name":                     "test_name",
+		"image_id":                 "test_image_id",
+		"instance_type":            "mini",
+		"security_group":           []interface{}{"test_security_group"},
+		"min_size":                 1,
+		"max_size":                 3,
+		"change_in_capacity":       1,
+		"scaleout":                 2,
+		"scaleout_condition":       "and",
+		"default_cooldown":         300,
+		"instance_lifecycle_limit": 10,
+		"description":              "test_description",
+		"load_balancer": []interface{}{
+			map[string]interface{}{
+				"load_balancer_name": "test_load_balancer_name",
+				"load_balancer_port": 80,
+				"instance_port":      8080,
+			},
+		},
+		"trigger": []interface{}{
+			map[string]interface{}{
+				"resource":        "Server-cpu",
+				"upper_threshold": 80.5,
+				"breach_duration": 5,
+			},
+		},
+		"schedule": []interface{}{
+			map[string]interface{}{
+				"days":               []interface{}{"monday", "friday"},
+				"starting_time_zone": "9",
+				"ending_time_zone":   "18",
+			},
+		},
+		"availability_zone": "test_availability_zone",
+		"instances":         []interface{}{"test_instance_id"},
+	})
+	rd.SetId("test_name")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	type args struct {
+		res *computing.NiftyDescribeAutoScalingGroupsOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeAutoScalingGroupsOutput{
+					AutoScalingReservationSet: []types.AutoScalingReservationSet{
+						{
+							AutoScalingGroupName:   nifcloud.String("test_name"),
+							ImageId:                nifcloud.String("test_image_id"),
+							InstanceType:           nifcloud.String("mini"),
+							GroupSet:               []types.GroupSet{{GroupId: nifcloud.String("test_security_group")}},
+							MinSize:                nifcloud.Int32(1),
+							MaxSize:                nifcloud.Int32(3),
+							ChangeInCapacity:       nifcloud.Int32(1),
+							Scaleout:               nifcloud.Int32(2),
+							ScaleoutCondition:      nifcloud.String("and"),
+							DefaultCooldown:        nifcloud.Int32(300),
+							InstanceLifecycleLimit: nifcloud.Int32(10),
+							Description:            nifcloud.String("test_description"),
+							LoadBalancing: []types.LoadBalancingOfNiftyDescribeAutoScalingGroups{
+								{
+									LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+									LoadBalancerPort: nifcloud.Int32(80),
+									InstancePort:     nifcloud.Int32(8080),
+								},
+							},
+							TriggerSet: []types.TriggerSet{
+								{
+									Resource:       nifcloud.String("Server-cpu"),
+									UpperThreshold: nifcloud.Float64(80.5),
+									BreachDuration: nifcloud.Int32(5),
+								},
+							},
+							ScheduleSet: []types.ScheduleSet{
+								{
+									Day: &types.Day{
+										SetSunday:    nifcloud.String("0"),
+										SetMonday:    nifcloud.String("1"),
+										SetTuesday:   nifcloud.String("0"),
+										SetWednesday: nifcloud.String("0"),
+										SetThursday:  nifcloud.String("0"),
+										SetFriday:    nifcloud.String("1"),
+										SetSaturday:  nifcloud.String("0"),
+									},
+									TimeZone: &types.TimeZone{
+										StartingTimeZone: nifcloud.String("9"),
+										EndingTimeZone:   nifcloud.String("18"),
+									},
+								},
+							},
+							Placement: &types.Placement{
+								AvailabilityZone: nifcloud.String("test_availability_zone"),
+							},
+							InstancesSet: []types.InstancesSetOfNiftyDescribeAutoScalingGroups{
+								{InstanceId: nifcloud.String("test_instance_id")},
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeAutoScalingGroupsOutput{
+					AutoScalingReservationSet: []types.AutoScalingReservationSet{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
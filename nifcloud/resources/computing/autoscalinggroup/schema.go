@@ -0,0 +1,230 @@
+package autoscalinggroup
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Provides an auto scaling group resource."
+
+// New returns the nifcloud_auto_scaling_group resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The auto scaling group name.",
+			Required:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 15),
+				validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z]+$`), "Enter the name within 1-15 characters [0-9a-zA-Z]."),
+			),
+		},
+		"image_id": {
+			Type:        schema.TypeString,
+			Description: "The image ID to launch the instances with.",
+			Required:    true,
+		},
+		"instance_type": {
+			Type:        schema.TypeString,
+			Description: "The type of the instances to launch.",
+			Optional:    true,
+			Default:     "mini",
+		},
+		"security_group": {
+			Type:        schema.TypeSet,
+			Description: "The security group names to associate the instances with.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"min_size": {
+			Type:         schema.TypeInt,
+			Description:  "The minimum number of instances.",
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"max_size": {
+			Type:         schema.TypeInt,
+			Description:  "The maximum number of instances.",
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"change_in_capacity": {
+			Type:         schema.TypeInt,
+			Description:  "The number of instances to add or remove at one scaling.",
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"scaleout": {
+			Type:        schema.TypeInt,
+			Description: "The number of times to scale out.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"scaleout_condition": {
+			Type:         schema.TypeString,
+			Description:  "The condition to combine the triggers. (`and` or `or`).",
+			Optional:     true,
+			Default:      "or",
+			ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+		},
+		"default_cooldown": {
+			Type:        schema.TypeInt,
+			Description: "The cooldown period in seconds after a scaling.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"instance_lifecycle_limit": {
+			Type:        schema.TypeInt,
+			Description: "The lifecycle limit of the instances launched by scaling.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"description": {
+			Type:             schema.TypeString,
+			Description:      "The auto scaling group description.",
+			Optional:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(0, 40),
+		},
+		"load_balancer": {
+			Type:        schema.TypeSet,
+			Description: "The load balancers to register the instances with.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"load_balancer_name": {
+						Type:        schema.TypeString,
+						Description: "The load balancer name.",
+						Required:    true,
+					},
+					"load_balancer_port": {
+						Type:         schema.TypeInt,
+						Description:  "The port on which the load balancer is listening.",
+						Required:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+					"instance_port": {
+						Type:         schema.TypeInt,
+						Description:  "The port on the instance to route to.",
+						Required:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+		"trigger": {
+			Type:        schema.TypeList,
+			Description: "The scaling triggers.",
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"resource": {
+						Type:        schema.TypeString,
+						Description: "The resource to monitor.",
+						Required:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Server-cpu",
+							"Server-memory",
+							"Server-network",
+							"LoadBalancer-network",
+						}, false),
+					},
+					"upper_threshold": {
+						Type:        schema.TypeFloat,
+						Description: "The threshold for scaling out.",
+						Required:    true,
+					},
+					"breach_duration": {
+						Type:        schema.TypeInt,
+						Description: "The duration in minutes that the threshold must be breached for.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"schedule": {
+			Type:        schema.TypeList,
+			Description: "The scaling schedules.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"days": {
+						Type:        schema.TypeSet,
+						Description: "The days of the week on which the schedule is enabled.",
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(weekdays, false),
+						},
+					},
+					"starting_dday": {
+						Type:        schema.TypeString,
+						Description: "The starting day of the month.",
+						Optional:    true,
+					},
+					"ending_dday": {
+						Type:        schema.TypeString,
+						Description: "The ending day of the month.",
+						Optional:    true,
+					},
+					"starting_month": {
+						Type:        schema.TypeString,
+						Description: "The starting month.",
+						Optional:    true,
+					},
+					"ending_month": {
+						Type:        schema.TypeString,
+						Description: "The ending month.",
+						Optional:    true,
+					},
+					"starting_time_zone": {
+						Type:        schema.TypeString,
+						Description: "The starting hour.",
+						Optional:    true,
+					},
+					"ending_time_zone": {
+						Type:        schema.TypeString,
+						Description: "The ending hour.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone of the auto scaling group.",
+			Computed:    true,
+		},
+		"instances": {
+			Type:        schema.TypeList,
+			Description: "The instance names currently launched by the auto scaling group.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
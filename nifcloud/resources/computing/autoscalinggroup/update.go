@@ -0,0 +1,27 @@
+package autoscalinggroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	// NiftyUpdateAutoScalingGroup requires the sizes and the triggers on every call,
+	// so all attributes are sent together.
+	input := expandNiftyUpdateAutoScalingGroupInput(d)
+
+	_, err := svc.NiftyUpdateAutoScalingGroup(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed updating auto scaling group: %s", err))
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return read(ctx, d, meta)
+}
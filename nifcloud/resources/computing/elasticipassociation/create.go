@@ -0,0 +1,37 @@
+package elasticipassociation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+	publicIP := d.Get("public_ip").(string)
+
+	if _, ok := d.GetOk("router_id"); ok {
+		if diags := updateRouterGlobalIPAddress(ctx, d, svc, publicIP); diags != nil {
+			return diags
+		}
+
+		d.SetId(publicIP)
+		return read(ctx, d, meta)
+	}
+
+	input := expandAssociateAddressInput(d)
+	if _, err := svc.AssociateAddress(ctx, input); err != nil {
+		return diag.FromErr(fmt.Errorf("failed associating elastic ip: %s", err))
+	}
+
+	d.SetId(publicIP)
+
+	if diags := waitForInstanceRunning(ctx, d, svc); diags != nil {
+		return diags
+	}
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,43 @@
+package elasticipassociation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if _, ok := d.GetOk("router_id"); ok {
+		// An empty ip address makes the router get a public ip address from DHCP again.
+		if diags := updateRouterGlobalIPAddress(ctx, d, svc, ""); diags != nil {
+			return diags
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	input := expandDisassociateAddressInput(d)
+	if _, err := svc.DisassociateAddress(ctx, input); err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.IpAddress" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed disassociating elastic ip: %s", err))
+	}
+
+	if diags := waitForInstanceRunning(ctx, d, svc); diags != nil {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,83 @@
+package elasticipassociation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandAssociateAddressInput(d *schema.ResourceData) *computing.AssociateAddressInput {
+	return &computing.AssociateAddressInput{
+		InstanceId:  nifcloud.String(d.Get("instance_id").(string)),
+		PublicIp:    nifcloud.String(d.Get("public_ip").(string)),
+		NiftyReboot: types.NiftyRebootOfAssociateAddressRequest(d.Get("reboot").(string)),
+	}
+}
+
+func expandDisassociateAddressInput(d *schema.ResourceData) *computing.DisassociateAddressInput {
+	return &computing.DisassociateAddressInput{
+		PublicIp:    nifcloud.String(d.Id()),
+		NiftyReboot: types.NiftyRebootOfDisassociateAddressRequest(d.Get("reboot").(string)),
+	}
+}
+
+func expandDescribeAddressesInput(d *schema.ResourceData) *computing.DescribeAddressesInput {
+	return &computing.DescribeAddressesInput{
+		PublicIp: []string{d.Id()},
+	}
+}
+
+func expandDescribeInstancesInput(d *schema.ResourceData) *computing.DescribeInstancesInput {
+	return &computing.DescribeInstancesInput{
+		InstanceId: []string{d.Get("instance_id").(string)},
+	}
+}
+
+func expandNiftyDescribeRoutersInput(d *schema.ResourceData) *computing.NiftyDescribeRoutersInput {
+	return &computing.NiftyDescribeRoutersInput{
+		RouterId: []string{d.Get("router_id").(string)},
+	}
+}
+
+// expandNiftyUpdateRouterNetworkInterfacesInput rebuilds the current network interfaces of the router
+// because NiftyUpdateRouterNetworkInterfaces replaces all of them.
+// Only the ip address of the global network interface is replaced with the given one.
+func expandNiftyUpdateRouterNetworkInterfacesInput(
+	d *schema.ResourceData,
+	res *computing.NiftyDescribeRoutersOutput,
+	ipAddress string,
+) (*computing.NiftyUpdateRouterNetworkInterfacesInput, error) {
+	if res == nil || len(res.RouterSet) == 0 {
+		return nil, fmt.Errorf("unable to find router: %s", d.Get("router_id").(string))
+	}
+
+	var networkInterface []types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces
+	var foundGlobal bool
+	for _, ni := range res.RouterSet[0].NetworkInterfaceSet {
+		n := types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces{
+			NetworkId:     ni.NetworkId,
+			IpAddress:     ni.IpAddress,
+			Dhcp:          ni.Dhcp,
+			DhcpConfigId:  ni.DhcpConfigId,
+			DhcpOptionsId: ni.DhcpOptionsId,
+		}
+		if nifcloud.ToString(ni.NetworkId) == globalNetworkID {
+			n.IpAddress = nifcloud.String(ipAddress)
+			foundGlobal = true
+		}
+		networkInterface = append(networkInterface, n)
+	}
+
+	if !foundGlobal {
+		return nil, fmt.Errorf("the router %s does not have a %s network interface", d.Get("router_id").(string), globalNetworkID)
+	}
+
+	return &computing.NiftyUpdateRouterNetworkInterfacesInput{
+		RouterId:         nifcloud.String(d.Get("router_id").(string)),
+		NetworkInterface: networkInterface,
+		NiftyReboot:      types.NiftyRebootOfNiftyUpdateRouterNetworkInterfacesRequest(d.Get("reboot").(string)),
+	}, nil
+}
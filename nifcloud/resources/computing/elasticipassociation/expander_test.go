@@ -0,0 +1,250 @@
+package elasticipassociation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandAssociateAddressInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"public_ip":   "192.0.2.1",
+		"instance_id": "test_instance_id",
+		"reboot":      "force",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.AssociateAddressInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.AssociateAddressInput{
+				InstanceId:  nifcloud.String("test_instance_id"),
+				PublicIp:    nifcloud.String("192.0.2.1"),
+				NiftyReboot: types.NiftyRebootOfAssociateAddressRequestForce,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandAssociateAddressInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDisassociateAddressInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id": "test_instance_id",
+		"reboot":      "false",
+	})
+	rd.SetId("192.0.2.1")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DisassociateAddressInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DisassociateAddressInput{
+				PublicIp:    nifcloud.String("192.0.2.1"),
+				NiftyReboot: types.NiftyRebootOfDisassociateAddressRequestFalse,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDisassociateAddressInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeAddressesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("192.0.2.1")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeAddressesInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeAddressesInput{
+				PublicIp: []string{"192.0.2.1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeAddressesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeInstancesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id": "test_instance_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeInstancesInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeInstancesInput{
+				InstanceId: []string{"test_instance_id"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeInstancesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeRoutersInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id": "test_router_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeRoutersInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeRoutersInput{
+				RouterId: []string{"test_router_id"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeRoutersInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyUpdateRouterNetworkInterfacesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"public_ip": "192.0.2.1",
+		"router_id": "test_router_id",
+		"reboot":    "true",
+	})
+
+	type args struct {
+		d         *schema.ResourceData
+		res       *computing.NiftyDescribeRoutersOutput
+		ipAddress string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *computing.NiftyUpdateRouterNetworkInterfacesInput
+		wantErr bool
+	}{
+		{
+			name: "expands the resource data with the global ip address replaced",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId: nifcloud.String("test_router_id"),
+							NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribeRouters{
+								{
+									NetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+									IpAddress: nifcloud.String("203.0.113.1"),
+								},
+								{
+									NetworkId:    nifcloud.String("test_network_id"),
+									IpAddress:    nifcloud.String("192.168.0.1"),
+									Dhcp:         nifcloud.Bool(true),
+									DhcpConfigId: nifcloud.String("test_dhcp_config_id"),
+								},
+							},
+						},
+					},
+				},
+				ipAddress: "192.0.2.1",
+			},
+			want: &computing.NiftyUpdateRouterNetworkInterfacesInput{
+				RouterId: nifcloud.String("test_router_id"),
+				NetworkInterface: []types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces{
+					{
+						NetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+						IpAddress: nifcloud.String("192.0.2.1"),
+					},
+					{
+						NetworkId:    nifcloud.String("test_network_id"),
+						IpAddress:    nifcloud.String("192.168.0.1"),
+						Dhcp:         nifcloud.Bool(true),
+						DhcpConfigId: nifcloud.String("test_dhcp_config_id"),
+					},
+				},
+				NiftyReboot: types.NiftyRebootOfNiftyUpdateRouterNetworkInterfacesRequestTrue,
+			},
+		},
+		{
+			name: "returns an error when the router does not have a global network interface",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId: nifcloud.String("test_router_id"),
+							NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribeRouters{
+								{
+									NetworkId: nifcloud.String("net-COMMON_PRIVATE"),
+								},
+							},
+						},
+					},
+				},
+				ipAddress: "192.0.2.1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error when the router is not found",
+			args: args{
+				d:         rd,
+				res:       &computing.NiftyDescribeRoutersOutput{},
+				ipAddress: "192.0.2.1",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandNiftyUpdateRouterNetworkInterfacesInput(tt.args.d, tt.args.res, tt.args.ipAddress)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package elasticipassociation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeAddressesOutput) error {
+	if res == nil || len(res.AddressesSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	address := res.AddressesSet[0]
+
+	if nifcloud.ToString(address.PublicIp) != d.Id() {
+		return fmt.Errorf("unable to find elastic ip within: %#v", res.AddressesSet)
+	}
+
+	// The association has gone when the elastic ip has been disassociated externally.
+	if nifcloud.ToString(address.InstanceId) == "" {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("public_ip", address.PublicIp); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_id", address.InstanceId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenRouter(d *schema.ResourceData, res *computing.NiftyDescribeRoutersOutput) error {
+	if res == nil || len(res.RouterSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	router := res.RouterSet[0]
+
+	if nifcloud.ToString(router.RouterId) != d.Get("router_id").(string) {
+		return fmt.Errorf("unable to find router within: %#v", res.RouterSet)
+	}
+
+	for _, ni := range router.NetworkInterfaceSet {
+		if nifcloud.ToString(ni.NetworkId) == globalNetworkID && nifcloud.ToString(ni.IpAddress) == d.Id() {
+			return d.Set("public_ip", ni.IpAddress)
+		}
+	}
+
+	// The association has gone when the ip address of the router has been changed externally.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,204 @@
+package elasticipassociation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"public_ip":   "192.0.2.1",
+		"instance_id": "test_instance_id",
+	})
+	rd.SetId("192.0.2.1")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantDisassociatedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	wantDisassociatedRd.SetId("192.0.2.1")
+
+	type args struct {
+		res *computing.DescribeAddressesOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.DescribeAddressesOutput{
+					AddressesSet: []types.AddressesSet{
+						{
+							PublicIp:   nifcloud.String("192.0.2.1"),
+							InstanceId: nifcloud.String("test_instance_id"),
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.DescribeAddressesOutput{
+					AddressesSet: []types.AddressesSet{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the elastic ip has been disassociated externally",
+			args: args{
+				d: wantDisassociatedRd,
+				res: &computing.DescribeAddressesOutput{
+					AddressesSet: []types.AddressesSet{
+						{
+							PublicIp: nifcloud.String("192.0.2.1"),
+						},
+					},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
+
+func TestFlattenRouter(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"public_ip": "192.0.2.1",
+		"router_id": "test_router_id",
+	})
+	rd.SetId("192.0.2.1")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantDisassociatedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id": "test_router_id",
+	})
+	wantDisassociatedRd.SetId("192.0.2.1")
+
+	wantDisassociatedStateRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id": "test_router_id",
+	})
+
+	type args struct {
+		res *computing.NiftyDescribeRoutersOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId: nifcloud.String("test_router_id"),
+							NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribeRouters{
+								{
+									NetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+									IpAddress: nifcloud.String("192.0.2.1"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the ip address of the router has been changed externally",
+			args: args{
+				d: wantDisassociatedRd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId: nifcloud.String("test_router_id"),
+							NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribeRouters{
+								{
+									NetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+									IpAddress: nifcloud.String("203.0.113.1"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: wantDisassociatedStateRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flattenRouter(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
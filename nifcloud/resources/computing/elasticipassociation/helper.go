@@ -0,0 +1,66 @@
+package elasticipassociation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+const (
+	waiterInitialDelay = 3
+	globalNetworkID    = "net-COMMON_GLOBAL"
+)
+
+func updateRouterGlobalIPAddress(ctx context.Context, d *schema.ResourceData, svc *computing.Client, ipAddress string) diag.Diagnostics {
+	routerID := d.Get("router_id").(string)
+
+	mutexkv.LockRouter(routerID)
+	defer mutexkv.UnlockRouter(routerID)
+
+	if d := waitForRouterAvailable(ctx, d, svc); d != nil {
+		return d
+	}
+
+	res, err := svc.NiftyDescribeRouters(ctx, expandNiftyDescribeRoutersInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading router: %s", err))
+	}
+
+	input, err := expandNiftyUpdateRouterNetworkInterfacesInput(d, res, ipAddress)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := svc.NiftyUpdateRouterNetworkInterfaces(ctx, input); err != nil {
+		return diag.FromErr(fmt.Errorf("failed updating router network_interface: %s", err))
+	}
+
+	return waitForRouterAvailable(ctx, d, svc)
+}
+
+func waitForRouterAvailable(ctx context.Context, d *schema.ResourceData, svc *computing.Client) diag.Diagnostics {
+	// lintignore:R018
+	time.Sleep(waiterInitialDelay * time.Second)
+	deadline, _ := ctx.Deadline()
+
+	if err := computing.NewRouterAvailableWaiter(svc).Wait(ctx, expandNiftyDescribeRoutersInput(d), time.Until(deadline)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for router available: %s", err))
+	}
+
+	return nil
+}
+
+func waitForInstanceRunning(ctx context.Context, d *schema.ResourceData, svc *computing.Client) diag.Diagnostics {
+	deadline, _ := ctx.Deadline()
+
+	if err := computing.NewInstanceRunningWaiter(svc).Wait(ctx, expandDescribeInstancesInput(d), time.Until(deadline)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for instance running: %s", err))
+	}
+
+	return nil
+}
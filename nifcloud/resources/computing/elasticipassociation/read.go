@@ -0,0 +1,48 @@
+package elasticipassociation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if _, ok := d.GetOk("router_id"); ok {
+		res, err := svc.NiftyDescribeRouters(ctx, expandNiftyDescribeRoutersInput(d))
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.RouterId" {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+		}
+
+		if err := flattenRouter(d, res); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	res, err := svc.DescribeAddresses(ctx, expandDescribeAddressesInput(d))
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.IpAddress" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+	}
+
+	if err := flatten(d, res); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package elasticipassociation
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Provides an elastic ip association resource."
+
+// New returns the nifcloud_elastic_ip_association resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"public_ip": {
+			Type:             schema.TypeString,
+			Description:      "The elastic ip address to associate.",
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validator.IPAddress,
+		},
+		"instance_id": {
+			Type:         schema.TypeString,
+			Description:  "The instance name to associate with the elastic ip.",
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"instance_id", "router_id"},
+		},
+		"router_id": {
+			Type:         schema.TypeString,
+			Description:  "The router id to associate with the elastic ip. The elastic ip is assigned to the `net-COMMON_GLOBAL` network interface of the router.",
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"instance_id", "router_id"},
+		},
+		"reboot": {
+			Type:         schema.TypeString,
+			Description:  "Whether to reboot the target while associating or disassociating. `false` is only available for instances.",
+			Optional:     true,
+			Default:      "true",
+			ValidateFunc: validation.StringInSlice([]string{"force", "true", "false"}, false),
+		},
+	}
+}
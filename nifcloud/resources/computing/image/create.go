@@ -0,0 +1,72 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+	deadline, _ := ctx.Deadline()
+
+	describeInstancesInput := expandDescribeInstancesInput(d)
+	describeInstancesRes, err := svc.DescribeInstances(ctx, describeInstancesInput)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed creating image for describe instances error: %s", err))
+	}
+
+	if len(describeInstancesRes.ReservationSet) == 0 || len(describeInstancesRes.ReservationSet[0].InstancesSet) == 0 {
+		return diag.FromErr(fmt.Errorf("failed creating image: unable to find instance %s", d.Get("instance_id")))
+	}
+
+	instance := describeInstancesRes.ReservationSet[0].InstancesSet[0]
+
+	// CreateImage is only allowed for a stopped instance.
+	// The instance stopped here is started again after the image becomes available.
+	stopped := false
+	if nifcloud.ToString(instance.InstanceState.Name) != "stopped" {
+		_, err := svc.StopInstances(ctx, expandStopInstancesInput(d))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed creating image for stop instances error: %s", err))
+		}
+
+		err = computing.NewInstanceStoppedWaiter(svc).Wait(ctx, describeInstancesInput, time.Until(deadline))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed creating image for wait until stopped instances error: %s", err))
+		}
+
+		stopped = true
+	}
+
+	input := expandCreateImageInput(d)
+	res, err := svc.CreateImageOperation(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed creating image: %s", err))
+	}
+
+	d.SetId(nifcloud.ToString(res.ImageId))
+
+	if err := waitUntilImageAvailable(ctx, d, svc, time.Until(deadline)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for image available: %s", err))
+	}
+
+	if stopped && d.Get("left_instance").(bool) {
+		if _, err := svc.StartInstances(ctx, expandStartInstancesInput(d)); err != nil {
+			return diag.FromErr(fmt.Errorf("failed starting instance after creating image: %s", err))
+		}
+
+		err = computing.NewInstanceRunningWaiter(svc).Wait(ctx, describeInstancesInput, time.Until(deadline))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for instance running after creating image: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,71 @@
+package image
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandCreateImageInput(d *schema.ResourceData) *computing.CreateImageOperationInput {
+	input := &computing.CreateImageOperationInput{
+		InstanceId:   nifcloud.String(d.Get("instance_id").(string)),
+		Name:         nifcloud.String(d.Get("image_name").(string)),
+		Description:  nifcloud.String(d.Get("description").(string)),
+		LeftInstance: nifcloud.Bool(d.Get("left_instance").(bool)),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.Placement = &types.RequestPlacementOfCreateImage{
+			AvailabilityZone: nifcloud.String(v.(string)),
+		}
+	}
+
+	return input
+}
+
+func expandDescribeInstancesInput(d *schema.ResourceData) *computing.DescribeInstancesInput {
+	return &computing.DescribeInstancesInput{
+		InstanceId: []string{d.Get("instance_id").(string)},
+	}
+}
+
+func expandStopInstancesInput(d *schema.ResourceData) *computing.StopInstancesInput {
+	return &computing.StopInstancesInput{
+		InstanceId: []string{d.Get("instance_id").(string)},
+	}
+}
+
+func expandStartInstancesInput(d *schema.ResourceData) *computing.StartInstancesInput {
+	return &computing.StartInstancesInput{
+		InstanceId: []string{d.Get("instance_id").(string)},
+	}
+}
+
+func expandDescribeImagesInput(d *schema.ResourceData) *computing.DescribeImagesInput {
+	return &computing.DescribeImagesInput{
+		ImageId: []string{d.Id()},
+	}
+}
+
+func expandModifyImageAttributeInputForImageName(d *schema.ResourceData) *computing.ModifyImageAttributeInput {
+	return &computing.ModifyImageAttributeInput{
+		ImageId:   nifcloud.String(d.Id()),
+		Attribute: types.AttributeOfModifyImageAttributeRequestImageName,
+		Value:     nifcloud.String(d.Get("image_name").(string)),
+	}
+}
+
+func expandModifyImageAttributeInputForDescription(d *schema.ResourceData) *computing.ModifyImageAttributeInput {
+	return &computing.ModifyImageAttributeInput{
+		ImageId:   nifcloud.String(d.Id()),
+		Attribute: types.AttributeOfModifyImageAttributeRequestDescription,
+		Value:     nifcloud.String(d.Get("description").(string)),
+	}
+}
+
+func expandDeleteImageInput(d *schema.ResourceData) *computing.DeleteImageInput {
+	return &computing.DeleteImageInput{
+		ImageId: nifcloud.String(d.Id()),
+	}
+}
@@ -0,0 +1,174 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandCreateImageInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id":       "test_instance_id",
+		"image_name":        "test_image_name",
+		"description":       "test_description",
+		"left_instance":     false,
+		"availability_zone": "test_availability_zone",
+	})
+	rdWithoutZone := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id": "test_instance_id",
+		"image_name":  "test_image_name",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.CreateImageOperationInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.CreateImageOperationInput{
+				InstanceId:   nifcloud.String("test_instance_id"),
+				Name:         nifcloud.String("test_image_name"),
+				Description:  nifcloud.String("test_description"),
+				LeftInstance: nifcloud.Bool(false),
+				Placement: &types.RequestPlacementOfCreateImage{
+					AvailabilityZone: nifcloud.String("test_availability_zone"),
+				},
+			},
+		},
+		{
+			name: "expands the resource data without availability_zone",
+			args: rdWithoutZone,
+			want: &computing.CreateImageOperationInput{
+				InstanceId:   nifcloud.String("test_instance_id"),
+				Name:         nifcloud.String("test_image_name"),
+				Description:  nifcloud.String(""),
+				LeftInstance: nifcloud.Bool(true),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandCreateImageInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeImagesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_image_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeImagesInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeImagesInput{
+				ImageId: []string{"test_image_id"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeImagesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandModifyImageAttributeInputForImageName(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"image_name": "test_image_name",
+	})
+	rd.SetId("test_image_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.ModifyImageAttributeInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.ModifyImageAttributeInput{
+				ImageId:   nifcloud.String("test_image_id"),
+				Attribute: types.AttributeOfModifyImageAttributeRequestImageName,
+				Value:     nifcloud.String("test_image_name"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandModifyImageAttributeInputForImageName(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandModifyImageAttributeInputForDescription(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"description": "test_description",
+	})
+	rd.SetId("test_image_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.ModifyImageAttributeInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.ModifyImageAttributeInput{
+				ImageId:   nifcloud.String("test_image_id"),
+				Attribute: types.AttributeOfModifyImageAttributeRequestDescription,
+				Value:     nifcloud.String("test_description"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandModifyImageAttributeInputForDescription(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDeleteImageInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_image_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DeleteImageInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DeleteImageInput{
+				ImageId: nifcloud.String("test_image_id"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDeleteImageInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
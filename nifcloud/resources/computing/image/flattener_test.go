@@ -0,0 +1,92 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id":       "test_instance_id",
+		"image_id":          "test_image_id",
+		"image_name":        "test_image_name",
+		"description":       "test_description",
+		"left_instance":     true,
+		"availability_zone": "test_availability_zone",
+		"image_state":       "available",
+	})
+	rd.SetId("test_image_id")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	type args struct {
+		res *computing.DescribeImagesOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.DescribeImagesOutput{
+					ImagesSet: []types.ImagesSet{
+						{
+							ImageId:     nifcloud.String("test_image_id"),
+							Name:        nifcloud.String("test_image_name"),
+							Description: nifcloud.String("test_description"),
+							ImageState:  nifcloud.String("available"),
+							Placement: &types.PlacementOfDescribeImages{
+								AvailabilityZone: nifcloud.String("test_availability_zone"),
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.DescribeImagesOutput{
+					ImagesSet: []types.ImagesSet{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
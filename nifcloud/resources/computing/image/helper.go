@@ -0,0 +1,40 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+const (
+	imageStateAvailable = "available"
+	imageStateFailed    = "failed"
+)
+
+func waitUntilImageAvailable(ctx context.Context, d *schema.ResourceData, svc *computing.Client, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		res, err := svc.DescribeImages(ctx, expandDescribeImagesInput(d))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if len(res.ImagesSet) == 0 {
+			return resource.RetryableError(fmt.Errorf("expected image %s to be found", d.Id()))
+		}
+
+		state := nifcloud.ToString(res.ImagesSet[0].ImageState)
+		switch state {
+		case imageStateAvailable:
+			return nil
+		case imageStateFailed:
+			return resource.NonRetryableError(fmt.Errorf("image %s is in state %s", d.Id(), state))
+		}
+
+		return resource.RetryableError(fmt.Errorf("expected image %s to be available but was in state %s", d.Id(), state))
+	})
+}
@@ -0,0 +1,85 @@
+package image
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Provides a custom image resource created from an instance."
+
+// New returns the nifcloud_image resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+			Create:  schema.DefaultTimeout(60 * time.Minute),
+			Delete:  schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The instance name to create the image from. A running instance is stopped before the image is created, and started again after the image becomes available unless `left_instance` is false.",
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 15),
+				validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z]+$`), "Enter the instance_id within 1-15 characters [0-9a-zA-Z]."),
+			),
+		},
+		"image_name": {
+			Type:             schema.TypeString,
+			Description:      "The name of the image.",
+			Required:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(1, 32),
+		},
+		"description": {
+			Type:             schema.TypeString,
+			Description:      "The image description.",
+			Optional:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(0, 255),
+		},
+		"left_instance": {
+			Type:        schema.TypeBool,
+			Description: "If true, the source instance is left after the image is created. If false, the source instance is deleted.",
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone where the image is stored.",
+			Optional:    true,
+			ForceNew:    true,
+			Computed:    true,
+		},
+		"image_id": {
+			Type:        schema.TypeString,
+			Description: "The image ID.",
+			Computed:    true,
+		},
+		"image_state": {
+			Type:        schema.TypeString,
+			Description: "The state of the image.",
+			Computed:    true,
+		},
+	}
+}
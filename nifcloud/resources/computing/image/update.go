@@ -0,0 +1,34 @@
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d.HasChange("image_name") {
+		input := expandModifyImageAttributeInputForImageName(d)
+
+		_, err := svc.ModifyImageAttribute(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating image name: %s", err))
+		}
+	}
+
+	if d.HasChange("description") {
+		input := expandModifyImageAttributeInputForDescription(d)
+
+		_, err := svc.ModifyImageAttribute(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating image description: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
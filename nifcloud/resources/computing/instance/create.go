@@ -26,5 +26,16 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	instance := res.InstancesSet[0]
 	d.SetId(nifcloud.ToString(instance.InstanceId))
 
-	return update(ctx, d, meta)
+	// update reads the instance back into d, so keep the configured groups beforehand.
+	groupIDs := multiIPAddressGroupIDs(d)
+
+	if diags := update(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	if err := associateMultiIPAddressGroups(ctx, d, svc, groupIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("failed associating multi ip address group: %s", err))
+	}
+
+	return read(ctx, d, meta)
 }
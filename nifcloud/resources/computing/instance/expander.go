@@ -14,6 +14,11 @@ func expandRunInstancesInput(d *schema.ResourceData) *computing.RunInstancesInpu
 	var networkInterface []types.RequestNetworkInterface
 	for _, ni := range d.Get("network_interface").(*schema.Set).List() {
 		if v, ok := ni.(map[string]interface{}); ok {
+			// The multi IP address group is associated after the instance is launched.
+			if row, ok := v["multi_ip_address_group_id"]; ok && row.(string) != "" {
+				continue
+			}
+
 			n := types.RequestNetworkInterface{}
 			if row, ok := v["network_id"]; ok {
 				n.NetworkId = nifcloud.String(row.(string))
@@ -147,6 +152,11 @@ func expandNiftyUpdateInstanceNetworkInterfacesInput(d *schema.ResourceData) *co
 	var networkInterface []types.RequestNetworkInterface
 	for _, ni := range d.Get("network_interface").(*schema.Set).List() {
 		if v, ok := ni.(map[string]interface{}); ok {
+			// The multi IP address group is associated after the instance is launched.
+			if row, ok := v["multi_ip_address_group_id"]; ok && row.(string) != "" {
+				continue
+			}
+
 			n := types.RequestNetworkInterface{}
 			if row, ok := v["network_id"]; ok {
 				n.NetworkId = nifcloud.String(row.(string))
@@ -185,6 +195,22 @@ func expandDetachNetworkInterfaceInput(d *schema.ResourceData, attachmentID stri
 	}
 }
 
+func expandAssociateMultiIPAddressGroupInput(uniqueID, multiIPAddressGroupID string) *computing.AssociateMultiIpAddressGroupInput {
+	return &computing.AssociateMultiIpAddressGroupInput{
+		InstanceUniqueId:      nifcloud.String(uniqueID),
+		MultiIpAddressGroupId: nifcloud.String(multiIPAddressGroupID),
+		NiftyReboot:           types.NiftyRebootOfAssociateMultiIpAddressGroupRequestForce,
+	}
+}
+
+func expandDisassociateMultiIPAddressGroupInput(uniqueID, multiIPAddressGroupID string) *computing.DisassociateMultiIpAddressGroupInput {
+	return &computing.DisassociateMultiIpAddressGroupInput{
+		InstanceUniqueId:      nifcloud.String(uniqueID),
+		MultiIpAddressGroupId: nifcloud.String(multiIPAddressGroupID),
+		NiftyReboot:           types.NiftyRebootOfDisassociateMultiIpAddressGroupRequestForce,
+	}
+}
+
 func expandDeregisterInstancesFromSecurityGroupInput(d *schema.ResourceData) *computing.DeregisterInstancesFromSecurityGroupInput {
 	groupName, _ := d.GetChange("security_group")
 
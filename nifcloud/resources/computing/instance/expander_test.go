@@ -389,11 +389,16 @@ func TestExpandModifyInstanceAttributeInputForSecurityGroup(t *testing.T) {
 func TestExpandNiftyUpdateInstanceNetworkInterfacesInput(t *testing.T) {
 	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
 		"instance_id": "test_instance_id",
-		"network_interface": []interface{}{map[string]interface{}{
-			"network_id":   "test_network_id",
-			"network_name": "test_network_name",
-			"ip_address":   "test_ip_address",
-		}},
+		"network_interface": []interface{}{
+			map[string]interface{}{
+				"network_id":   "test_network_id",
+				"network_name": "test_network_name",
+				"ip_address":   "test_ip_address",
+			},
+			map[string]interface{}{
+				"multi_ip_address_group_id": "test_multi_ip_address_group_id",
+			},
+		},
 	})
 	rd.SetId("test_instance_id")
 
@@ -481,6 +486,52 @@ func TestExpandDetachNetworkInterfaceInput(t *testing.T) {
 	}
 }
 
+func TestExpandAssociateMultiIPAddressGroupInput(t *testing.T) {
+	tests := []struct {
+		name string
+		want *computing.AssociateMultiIpAddressGroupInput
+	}{
+		{
+			name: "expands the arguments",
+			want: &computing.AssociateMultiIpAddressGroupInput{
+				InstanceUniqueId:      nifcloud.String("test_unique_id"),
+				MultiIpAddressGroupId: nifcloud.String("test_multi_ip_address_group_id"),
+				NiftyReboot:           types.NiftyRebootOfAssociateMultiIpAddressGroupRequestForce,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandAssociateMultiIPAddressGroupInput("test_unique_id", "test_multi_ip_address_group_id")
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDisassociateMultiIPAddressGroupInput(t *testing.T) {
+	tests := []struct {
+		name string
+		want *computing.DisassociateMultiIpAddressGroupInput
+	}{
+		{
+			name: "expands the arguments",
+			want: &computing.DisassociateMultiIpAddressGroupInput{
+				InstanceUniqueId:      nifcloud.String("test_unique_id"),
+				MultiIpAddressGroupId: nifcloud.String("test_multi_ip_address_group_id"),
+				NiftyReboot:           types.NiftyRebootOfDisassociateMultiIpAddressGroupRequestForce,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDisassociateMultiIPAddressGroupInput("test_unique_id", "test_multi_ip_address_group_id")
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestExpandDeregisterInstancesFromSecurityGroupInput(t *testing.T) {
 	r := New()
 	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
@@ -23,13 +23,20 @@ func TestFlatten(t *testing.T) {
 		"key_name":                "test_key_name",
 		"license_name":            "test_license_name",
 		"license_num":             200,
-		"network_interface": []interface{}{map[string]interface{}{
-			"network_id":                      "test_network_id",
-			"network_name":                    "test_network_name",
-			"ip_address":                      "test_ip_address",
-			"network_interface_id":            "test_network_interface_id",
-			"network_interface_attachment_id": "test_network_interface_attachment_id",
-		}},
+		"network_interface": []interface{}{
+			map[string]interface{}{
+				"network_id":                      "test_network_id",
+				"network_name":                    "test_network_name",
+				"ip_address":                      "test_ip_address",
+				"network_interface_id":            "test_network_interface_id",
+				"network_interface_attachment_id": "test_network_interface_attachment_id",
+			},
+			map[string]interface{}{
+				"multi_ip_address_group_id":       "test_multi_ip_address_group_id",
+				"network_interface_id":            "test_multi_ip_network_interface_id",
+				"network_interface_attachment_id": "test_multi_ip_network_interface_attachment_id",
+			},
+		},
 		"password":       "test_password",
 		"security_group": "test_security_group",
 		"user_data":      "test_user_data",
@@ -85,6 +92,16 @@ func TestFlatten(t *testing.T) {
 												AttachmentId: nifcloud.String("test_network_interface_attachment_id"),
 											},
 										},
+										{
+											NiftyNetworkId:     nifcloud.String("net-MULTI_IP_ADDRESS"),
+											NetworkInterfaceId: nifcloud.String("test_multi_ip_network_interface_id"),
+											Attachment: &types.Attachment{
+												AttachmentId: nifcloud.String("test_multi_ip_network_interface_attachment_id"),
+											},
+										},
+									},
+									MultiIpAddressGroup: &types.MultiIpAddressGroup{
+										MultiIpAddressGroupId: nifcloud.String("test_multi_ip_address_group_id"),
 									},
 								},
 							},
@@ -2,6 +2,8 @@ package instance
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
@@ -61,3 +63,51 @@ func getRouterList(ctx context.Context, d *schema.ResourceData, svc *computing.C
 
 	return result, nil
 }
+
+func getInstanceUniqueID(ctx context.Context, d *schema.ResourceData, svc *computing.Client) (string, error) {
+	res, err := svc.DescribeInstances(ctx, expandDescribeInstancesInput(d))
+	if err != nil {
+		return "", err
+	}
+
+	if len(res.ReservationSet) == 0 || len(res.ReservationSet[0].InstancesSet) == 0 {
+		return "", fmt.Errorf("instance %s does not found", d.Id())
+	}
+
+	return nifcloud.ToString(res.ReservationSet[0].InstancesSet[0].InstanceUniqueId), nil
+}
+
+func multiIPAddressGroupIDs(d *schema.ResourceData) []string {
+	var groupIDs []string
+	for _, ni := range d.Get("network_interface").(*schema.Set).List() {
+		if groupID, ok := ni.(map[string]interface{})["multi_ip_address_group_id"]; ok && groupID != "" {
+			groupIDs = append(groupIDs, groupID.(string))
+		}
+	}
+	return groupIDs
+}
+
+func associateMultiIPAddressGroups(ctx context.Context, d *schema.ResourceData, svc *computing.Client, groupIDs []string) error {
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	deadline, _ := ctx.Deadline()
+
+	uniqueID, err := getInstanceUniqueID(ctx, d, svc)
+	if err != nil {
+		return err
+	}
+
+	for _, groupID := range groupIDs {
+		if _, err := svc.AssociateMultiIpAddressGroup(ctx, expandAssociateMultiIPAddressGroupInput(uniqueID, groupID)); err != nil {
+			return err
+		}
+
+		err := computing.NewInstanceRunningWaiter(svc).Wait(ctx, expandDescribeInstancesInput(d), time.Until(deadline))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
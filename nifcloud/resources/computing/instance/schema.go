@@ -150,6 +150,12 @@ func newSchema() map[string]*schema.Schema {
 						Description: "The attachment ID of the additional NIC",
 						Computed:    true,
 					},
+					"multi_ip_address_group_id": {
+						Type: schema.TypeString,
+						Description: `The ID of the multi IP address group, which can be managed using the nifcloud_multi_ip_address_group resource.
+						Leave network_id and network_name empty when this is set. Modifying this field instance will force reboot.`,
+						Optional: true,
+					},
 				},
 			},
 		},
@@ -137,6 +137,11 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		ors := o.(*schema.Set).Difference(n.(*schema.Set))
 		nrs := n.(*schema.Set).Difference(o.(*schema.Set))
 
+		uniqueID, err := getInstanceUniqueID(ctx, d, svc)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating instance network for get instance unique id: %s", err))
+		}
+
 		// Now first loop through all the old network interface and detach any obsolete ones
 		for _, n := range ors.List() {
 			if attachmentID, ok := n.(map[string]interface{})["network_interface_attachment_id"]; ok && attachmentID != "" {
@@ -159,6 +164,20 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 				}
 
 			}
+
+			if groupID, ok := n.(map[string]interface{})["multi_ip_address_group_id"]; ok && groupID != "" {
+				input := expandDisassociateMultiIPAddressGroupInput(uniqueID, groupID.(string))
+				_, err := svc.DisassociateMultiIpAddressGroup(ctx, input)
+
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("failed updating instance to disassociate multi ip address group: %s", err))
+				}
+
+				err = computing.NewInstanceRunningWaiter(svc).Wait(ctx, expandDescribeInstancesInput(d), time.Until(deadline))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("failed wait until instance running: %s", err))
+				}
+			}
 		}
 
 		// Then loop through all the newly configured network interface and attach them
@@ -182,6 +201,21 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 					}
 				}
 			}
+
+			// The multi IP address group of the new instance is associated in create.
+			if groupID, ok := n.(map[string]interface{})["multi_ip_address_group_id"]; ok && groupID != "" && !d.IsNewResource() {
+				input := expandAssociateMultiIPAddressGroupInput(uniqueID, groupID.(string))
+				_, err := svc.AssociateMultiIpAddressGroup(ctx, input)
+
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("failed updating instance to associate multi ip address group: %s", err))
+				}
+
+				err = computing.NewInstanceRunningWaiter(svc).Wait(ctx, expandDescribeInstancesInput(d), time.Until(deadline))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("failed wait until instance running: %s", err))
+				}
+			}
 		}
 	}
 
@@ -0,0 +1,29 @@
+package instancebackuprule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandDeleteInstanceBackupRuleInput(d)
+
+	svc := meta.(*client.Client).Computing
+	if _, err := svc.DeleteInstanceBackupRule(ctx, input); err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.InstanceBackupRuleId" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed deleting: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,71 @@
+package instancebackuprule
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandCreateInstanceBackupRuleInput(d *schema.ResourceData) *computing.CreateInstanceBackupRuleInput {
+	return &computing.CreateInstanceBackupRuleInput{
+		InstanceBackupRuleName: nifcloud.String(d.Get("name").(string)),
+		InstanceUniqueId:       expandInstanceUniqueIds(d.Get("instance_unique_id").(*schema.Set).List()),
+		TimeSlotId:             types.TimeSlotIdOfCreateInstanceBackupRuleRequest(d.Get("time_slot_id").(string)),
+		BackupInstanceMaxCount: nifcloud.Int32(int32(d.Get("backup_instance_max_count").(int))),
+		Description:            nifcloud.String(d.Get("description").(string)),
+	}
+}
+
+func expandInstanceUniqueIds(raw []interface{}) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(raw))
+	for i, l := range raw {
+		ids[i] = l.(string)
+	}
+
+	return ids
+}
+
+func expandDescribeInstanceBackupRulesInput(d *schema.ResourceData) *computing.DescribeInstanceBackupRulesInput {
+	return &computing.DescribeInstanceBackupRulesInput{
+		InstanceBackupRuleId: []string{d.Id()},
+	}
+}
+
+func expandModifyInstanceBackupRuleAttributeInputForName(d *schema.ResourceData) *computing.ModifyInstanceBackupRuleAttributeInput {
+	return &computing.ModifyInstanceBackupRuleAttributeInput{
+		InstanceBackupRuleId:   nifcloud.String(d.Id()),
+		InstanceBackupRuleName: nifcloud.String(d.Get("name").(string)),
+	}
+}
+
+func expandModifyInstanceBackupRuleAttributeInputForTimeSlotID(d *schema.ResourceData) *computing.ModifyInstanceBackupRuleAttributeInput {
+	return &computing.ModifyInstanceBackupRuleAttributeInput{
+		InstanceBackupRuleId: nifcloud.String(d.Id()),
+		TimeSlotId:           types.TimeSlotIdOfModifyInstanceBackupRuleAttributeRequest(d.Get("time_slot_id").(string)),
+	}
+}
+
+func expandModifyInstanceBackupRuleAttributeInputForBackupInstanceMaxCount(d *schema.ResourceData) *computing.ModifyInstanceBackupRuleAttributeInput {
+	return &computing.ModifyInstanceBackupRuleAttributeInput{
+		InstanceBackupRuleId:   nifcloud.String(d.Id()),
+		BackupInstanceMaxCount: nifcloud.Int32(int32(d.Get("backup_instance_max_count").(int))),
+	}
+}
+
+func expandModifyInstanceBackupRuleAttributeInputForDescription(d *schema.ResourceData) *computing.ModifyInstanceBackupRuleAttributeInput {
+	return &computing.ModifyInstanceBackupRuleAttributeInput{
+		InstanceBackupRuleId: nifcloud.String(d.Id()),
+		Description:          nifcloud.String(d.Get("description").(string)),
+	}
+}
+
+func expandDeleteInstanceBackupRuleInput(d *schema.ResourceData) *computing.DeleteInstanceBackupRuleInput {
+	return &computing.DeleteInstanceBackupRuleInput{
+		InstanceBackupRuleId: nifcloud.String(d.Id()),
+	}
+}
@@ -0,0 +1,159 @@
+package instancebackuprule
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandCreateInstanceBackupRuleInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name":                      "test_name",
+		"instance_unique_id":        []interface{}{"test_instance_unique_id"},
+		"time_slot_id":              "1",
+		"backup_instance_max_count": 2,
+		"description":               "test_description",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.CreateInstanceBackupRuleInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.CreateInstanceBackupRuleInput{
+				InstanceBackupRuleName: nifcloud.String("test_name"),
+				InstanceUniqueId:       []string{"test_instance_unique_id"},
+				TimeSlotId:             types.TimeSlotIdOfCreateInstanceBackupRuleRequestFrom000To159,
+				BackupInstanceMaxCount: nifcloud.Int32(2),
+				Description:            nifcloud.String("test_description"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandCreateInstanceBackupRuleInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeInstanceBackupRulesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_instance_backup_rule_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeInstanceBackupRulesInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeInstanceBackupRulesInput{
+				InstanceBackupRuleId: []string{"test_instance_backup_rule_id"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeInstanceBackupRulesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandModifyInstanceBackupRuleAttributeInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name":                      "test_name",
+		"time_slot_id":              "12",
+		"backup_instance_max_count": 3,
+		"description":               "test_description",
+	})
+	rd.SetId("test_instance_backup_rule_id")
+
+	tests := []struct {
+		name     string
+		args     *schema.ResourceData
+		expander func(*schema.ResourceData) *computing.ModifyInstanceBackupRuleAttributeInput
+		want     *computing.ModifyInstanceBackupRuleAttributeInput
+	}{
+		{
+			name:     "expands the resource data for name",
+			args:     rd,
+			expander: expandModifyInstanceBackupRuleAttributeInputForName,
+			want: &computing.ModifyInstanceBackupRuleAttributeInput{
+				InstanceBackupRuleId:   nifcloud.String("test_instance_backup_rule_id"),
+				InstanceBackupRuleName: nifcloud.String("test_name"),
+			},
+		},
+		{
+			name:     "expands the resource data for time_slot_id",
+			args:     rd,
+			expander: expandModifyInstanceBackupRuleAttributeInputForTimeSlotID,
+			want: &computing.ModifyInstanceBackupRuleAttributeInput{
+				InstanceBackupRuleId: nifcloud.String("test_instance_backup_rule_id"),
+				TimeSlotId:           types.TimeSlotIdOfModifyInstanceBackupRuleAttributeRequestFrom2200To2359,
+			},
+		},
+		{
+			name:     "expands the resource data for backup_instance_max_count",
+			args:     rd,
+			expander: expandModifyInstanceBackupRuleAttributeInputForBackupInstanceMaxCount,
+			want: &computing.ModifyInstanceBackupRuleAttributeInput{
+				InstanceBackupRuleId:   nifcloud.String("test_instance_backup_rule_id"),
+				BackupInstanceMaxCount: nifcloud.Int32(3),
+			},
+		},
+		{
+			name:     "expands the resource data for description",
+			args:     rd,
+			expander: expandModifyInstanceBackupRuleAttributeInputForDescription,
+			want: &computing.ModifyInstanceBackupRuleAttributeInput{
+				InstanceBackupRuleId: nifcloud.String("test_instance_backup_rule_id"),
+				Description:          nifcloud.String("test_description"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.expander(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDeleteInstanceBackupRuleInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_instance_backup_rule_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DeleteInstanceBackupRuleInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DeleteInstanceBackupRuleInput{
+				InstanceBackupRuleId: nifcloud.String("test_instance_backup_rule_id"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDeleteInstanceBackupRuleInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
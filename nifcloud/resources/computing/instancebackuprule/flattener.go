@@ -0,0 +1,62 @@
+package instancebackuprule
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeInstanceBackupRulesOutput) error {
+	if res == nil || len(res.InstanceBackupRulesSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	rule := res.InstanceBackupRulesSet[0]
+
+	if nifcloud.ToString(rule.InstanceBackupRuleId) != d.Id() {
+		return fmt.Errorf("unable to find instance backup rule within: %#v", res.InstanceBackupRulesSet)
+	}
+
+	if err := d.Set("instance_backup_rule_id", rule.InstanceBackupRuleId); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", rule.InstanceBackupRuleName); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_unique_id", flattenInstanceUniqueID(rule.InstancesSet)); err != nil {
+		return err
+	}
+
+	if err := d.Set("time_slot_id", rule.TimeSlotId); err != nil {
+		return err
+	}
+
+	if err := d.Set("backup_instance_max_count", rule.BackupInstanceMaxCount); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", rule.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("availability_zone", rule.AvailabilityZone); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenInstanceUniqueID(instancesSet []types.InstancesSetOfDescribeInstanceBackupRules) []string {
+	ids := make([]string, len(instancesSet))
+
+	for i, instance := range instancesSet {
+		ids[i] = nifcloud.ToString(instance.InstanceUniqueId)
+	}
+	return ids
+}
@@ -0,0 +1,97 @@
+package instancebackuprule
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_backup_rule_id":   "test_instance_backup_rule_id",
+		"name":                      "test_name",
+		"instance_unique_id":        []interface{}{"test_instance_unique_id"},
+		"time_slot_id":              "1",
+		"backup_instance_max_count": 2,
+		"description":               "test_description",
+		"availability_zone":         "test_availability_zone",
+	})
+	rd.SetId("test_instance_backup_rule_id")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	type args struct {
+		res *computing.DescribeInstanceBackupRulesOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.DescribeInstanceBackupRulesOutput{
+					InstanceBackupRulesSet: []types.InstanceBackupRulesSet{
+						{
+							InstanceBackupRuleId:   nifcloud.String("test_instance_backup_rule_id"),
+							InstanceBackupRuleName: nifcloud.String("test_name"),
+							InstancesSet: []types.InstancesSetOfDescribeInstanceBackupRules{
+								{
+									InstanceId:       nifcloud.String("test_instance_id"),
+									InstanceUniqueId: nifcloud.String("test_instance_unique_id"),
+								},
+							},
+							TimeSlotId:             nifcloud.String("1"),
+							BackupInstanceMaxCount: nifcloud.Int32(2),
+							Description:            nifcloud.String("test_description"),
+							AvailabilityZone:       nifcloud.String("test_availability_zone"),
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.DescribeInstanceBackupRulesOutput{
+					InstanceBackupRulesSet: []types.InstanceBackupRulesSet{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
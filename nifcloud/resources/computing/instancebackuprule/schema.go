@@ -0,0 +1,77 @@
+package instancebackuprule
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Provides an instance backup rule resource."
+
+// New returns the nifcloud_instance_backup_rule resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:             schema.TypeString,
+			Description:      "The instance backup rule name.",
+			Required:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(1, 255),
+		},
+		"instance_unique_id": {
+			Type:        schema.TypeSet,
+			Description: "The unique ID of the instances to back up.",
+			Required:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"time_slot_id": {
+			Type:         schema.TypeString,
+			Description:  "The ID of the time slot when backup is taken. (1: 0:00-1:59, 2: 2:00-3:59, ... 12: 22:00-23:59).",
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}, false),
+		},
+		"backup_instance_max_count": {
+			Type:         schema.TypeInt,
+			Description:  "The maximum number of backup generations to keep.",
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 10),
+		},
+		"description": {
+			Type:             schema.TypeString,
+			Description:      "The instance backup rule description.",
+			Optional:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(0, 255),
+		},
+		"instance_backup_rule_id": {
+			Type:        schema.TypeString,
+			Description: "The instance backup rule ID.",
+			Computed:    true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone of the instance backup rule.",
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package instancebackuprule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d.HasChange("name") {
+		input := expandModifyInstanceBackupRuleAttributeInputForName(d)
+
+		_, err := svc.ModifyInstanceBackupRuleAttribute(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating instance backup rule name: %s", err))
+		}
+	}
+
+	if d.HasChange("time_slot_id") {
+		input := expandModifyInstanceBackupRuleAttributeInputForTimeSlotID(d)
+
+		_, err := svc.ModifyInstanceBackupRuleAttribute(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating instance backup rule time_slot_id: %s", err))
+		}
+	}
+
+	if d.HasChange("backup_instance_max_count") {
+		input := expandModifyInstanceBackupRuleAttributeInputForBackupInstanceMaxCount(d)
+
+		_, err := svc.ModifyInstanceBackupRuleAttribute(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating instance backup rule backup_instance_max_count: %s", err))
+		}
+	}
+
+	if d.HasChange("description") {
+		input := expandModifyInstanceBackupRuleAttributeInputForDescription(d)
+
+		_, err := svc.ModifyInstanceBackupRuleAttribute(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating instance backup rule description: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
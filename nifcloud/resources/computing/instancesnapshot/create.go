@@ -0,0 +1,37 @@
+package instancesnapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandNiftyCreateInstanceSnapshotInput(d)
+	svc := meta.(*client.Client).Computing
+	deadline, _ := ctx.Deadline()
+
+	if _, err := svc.NiftyCreateInstanceSnapshot(ctx, input); err != nil {
+		return diag.FromErr(fmt.Errorf("failed creating instance snapshot: %s", err))
+	}
+
+	// NiftyCreateInstanceSnapshot returns only the snapshot name,
+	// so look up the snapshot ID from the name and the instance.
+	id, err := waitUntilSnapshotExists(ctx, d, svc, time.Until(deadline))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for instance snapshot exists: %s", err))
+	}
+	d.SetId(id)
+
+	err = computing.NewSnapshotNormalWaiter(svc).Wait(ctx, expandNiftyDescribeInstanceSnapshotsInput(d), time.Until(deadline))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for instance snapshot normal: %s", err))
+	}
+
+	return read(ctx, d, meta)
+}
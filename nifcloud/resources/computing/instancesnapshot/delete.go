@@ -0,0 +1,37 @@
+package instancesnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+	deadline, _ := ctx.Deadline()
+
+	input := expandNiftyDeleteInstanceSnapshotInput(d)
+	if _, err := svc.NiftyDeleteInstanceSnapshot(ctx, input); err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Snapshot" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed deleting instance snapshot: %s", err))
+	}
+
+	err := computing.NewSnapshotDeletedWaiter(svc).Wait(ctx, expandNiftyDescribeInstanceSnapshotsInput(d), time.Until(deadline))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for instance snapshot deleted: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,66 @@
+package instancesnapshot
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandNiftyCreateInstanceSnapshotInput(d *schema.ResourceData) *computing.NiftyCreateInstanceSnapshotInput {
+	return &computing.NiftyCreateInstanceSnapshotInput{
+		InstanceId:   nifcloud.String(d.Get("instance_id").(string)),
+		SnapshotName: nifcloud.String(d.Get("snapshot_name").(string)),
+		Description:  nifcloud.String(d.Get("description").(string)),
+	}
+}
+
+func expandNiftyDescribeInstanceSnapshotsInputWithName(d *schema.ResourceData) *computing.NiftyDescribeInstanceSnapshotsInput {
+	return &computing.NiftyDescribeInstanceSnapshotsInput{
+		SnapshotName: []string{d.Get("snapshot_name").(string)},
+	}
+}
+
+func expandNiftyDescribeInstanceSnapshotsInput(d *schema.ResourceData) *computing.NiftyDescribeInstanceSnapshotsInput {
+	return &computing.NiftyDescribeInstanceSnapshotsInput{
+		InstanceSnapshotId: []string{d.Id()},
+	}
+}
+
+func expandNiftyModifyInstanceSnapshotAttributeInputForDescription(d *schema.ResourceData) *computing.NiftyModifyInstanceSnapshotAttributeInput {
+	return &computing.NiftyModifyInstanceSnapshotAttributeInput{
+		InstanceSnapshotId: nifcloud.String(d.Id()),
+		Attribute:          types.AttributeOfNiftyModifyInstanceSnapshotAttributeRequestDescription,
+		Value:              nifcloud.String(d.Get("description").(string)),
+	}
+}
+
+func expandNiftyRestoreInstanceSnapshotInput(d *schema.ResourceData) *computing.NiftyRestoreInstanceSnapshotInput {
+	return &computing.NiftyRestoreInstanceSnapshotInput{
+		InstanceSnapshotId: nifcloud.String(d.Id()),
+	}
+}
+
+func expandNiftyDeleteInstanceSnapshotInput(d *schema.ResourceData) *computing.NiftyDeleteInstanceSnapshotInput {
+	return &computing.NiftyDeleteInstanceSnapshotInput{
+		InstanceSnapshotId: nifcloud.String(d.Id()),
+	}
+}
+
+func expandDescribeInstancesInput(d *schema.ResourceData) *computing.DescribeInstancesInput {
+	return &computing.DescribeInstancesInput{
+		InstanceId: []string{d.Get("instance_id").(string)},
+	}
+}
+
+func expandStopInstancesInput(d *schema.ResourceData) *computing.StopInstancesInput {
+	return &computing.StopInstancesInput{
+		InstanceId: []string{d.Get("instance_id").(string)},
+	}
+}
+
+func expandStartInstancesInput(d *schema.ResourceData) *computing.StartInstancesInput {
+	return &computing.StartInstancesInput{
+		InstanceId: []string{d.Get("instance_id").(string)},
+	}
+}
@@ -0,0 +1,177 @@
+package instancesnapshot
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandNiftyCreateInstanceSnapshotInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id":   "test_instance_id",
+		"snapshot_name": "test_snapshot_name",
+		"description":   "test_description",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyCreateInstanceSnapshotInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyCreateInstanceSnapshotInput{
+				InstanceId:   nifcloud.String("test_instance_id"),
+				SnapshotName: nifcloud.String("test_snapshot_name"),
+				Description:  nifcloud.String("test_description"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyCreateInstanceSnapshotInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeInstanceSnapshotsInputWithName(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"snapshot_name": "test_snapshot_name",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeInstanceSnapshotsInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeInstanceSnapshotsInput{
+				SnapshotName: []string{"test_snapshot_name"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeInstanceSnapshotsInputWithName(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeInstanceSnapshotsInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_instance_snapshot_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeInstanceSnapshotsInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeInstanceSnapshotsInput{
+				InstanceSnapshotId: []string{"test_instance_snapshot_id"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeInstanceSnapshotsInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyModifyInstanceSnapshotAttributeInputForDescription(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"description": "test_description",
+	})
+	rd.SetId("test_instance_snapshot_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyModifyInstanceSnapshotAttributeInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyModifyInstanceSnapshotAttributeInput{
+				InstanceSnapshotId: nifcloud.String("test_instance_snapshot_id"),
+				Attribute:          types.AttributeOfNiftyModifyInstanceSnapshotAttributeRequestDescription,
+				Value:              nifcloud.String("test_description"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyModifyInstanceSnapshotAttributeInputForDescription(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyRestoreInstanceSnapshotInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_instance_snapshot_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyRestoreInstanceSnapshotInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyRestoreInstanceSnapshotInput{
+				InstanceSnapshotId: nifcloud.String("test_instance_snapshot_id"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyRestoreInstanceSnapshotInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDeleteInstanceSnapshotInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_instance_snapshot_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDeleteInstanceSnapshotInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDeleteInstanceSnapshotInput{
+				InstanceSnapshotId: nifcloud.String("test_instance_snapshot_id"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDeleteInstanceSnapshotInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
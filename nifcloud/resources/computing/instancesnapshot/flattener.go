@@ -0,0 +1,56 @@
+package instancesnapshot
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.NiftyDescribeInstanceSnapshotsOutput) error {
+	if res == nil || len(res.SnapshotInfoSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	snapshot := res.SnapshotInfoSet[0]
+
+	if nifcloud.ToString(snapshot.InstanceSnapshotId) != d.Id() {
+		return fmt.Errorf("unable to find instance snapshot within: %#v", res.SnapshotInfoSet)
+	}
+
+	if err := d.Set("instance_snapshot_id", snapshot.InstanceSnapshotId); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_id", snapshot.InstanceId); err != nil {
+		return err
+	}
+
+	if err := d.Set("snapshot_name", snapshot.SnapshotName); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", snapshot.Memo); err != nil {
+		return err
+	}
+
+	if err := d.Set("status", snapshot.Status); err != nil {
+		return err
+	}
+
+	if err := d.Set("power_status", snapshot.PowerStatus); err != nil {
+		return err
+	}
+
+	if err := d.Set("created_time", snapshot.CreatedTime); err != nil {
+		return err
+	}
+
+	if err := d.Set("expired_time", snapshot.ExpiredTime); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package instancesnapshot
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_snapshot_id": "test_instance_snapshot_id",
+		"instance_id":          "test_instance_id",
+		"snapshot_name":        "test_snapshot_name",
+		"description":          "test_description",
+		"status":               "normal",
+		"power_status":         "running",
+		"created_time":         "test_created_time",
+		"expired_time":         "test_expired_time",
+	})
+	rd.SetId("test_instance_snapshot_id")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	type args struct {
+		res *computing.NiftyDescribeInstanceSnapshotsOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeInstanceSnapshotsOutput{
+					SnapshotInfoSet: []types.SnapshotInfoSet{
+						{
+							InstanceSnapshotId: nifcloud.String("test_instance_snapshot_id"),
+							InstanceId:         nifcloud.String("test_instance_id"),
+							SnapshotName:       nifcloud.String("test_snapshot_name"),
+							Memo:               nifcloud.String("test_description"),
+							Status:             nifcloud.String("normal"),
+							PowerStatus:        nifcloud.String("running"),
+							CreatedTime:        nifcloud.String("test_created_time"),
+							ExpiredTime:        nifcloud.String("test_expired_time"),
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeInstanceSnapshotsOutput{
+					SnapshotInfoSet: []types.SnapshotInfoSet{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
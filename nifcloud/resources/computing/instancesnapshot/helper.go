@@ -0,0 +1,91 @@
+package instancesnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+// restore restores the instance from the snapshot.
+// A running instance is stopped before restoring and started again afterwards.
+func restore(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	deadline, _ := ctx.Deadline()
+
+	describeInstancesInput := expandDescribeInstancesInput(d)
+	res, err := svc.DescribeInstances(ctx, describeInstancesInput)
+	if err != nil {
+		return err
+	}
+
+	if len(res.ReservationSet) == 0 || len(res.ReservationSet[0].InstancesSet) == 0 {
+		return fmt.Errorf("unable to find instance %s", d.Get("instance_id"))
+	}
+
+	instance := res.ReservationSet[0].InstancesSet[0]
+
+	running := nifcloud.ToString(instance.InstanceState.Name) != "stopped"
+	if running {
+		if _, err := svc.StopInstances(ctx, expandStopInstancesInput(d)); err != nil {
+			return err
+		}
+
+		err = computing.NewInstanceStoppedWaiter(svc).Wait(ctx, describeInstancesInput, time.Until(deadline))
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := svc.NiftyRestoreInstanceSnapshot(ctx, expandNiftyRestoreInstanceSnapshotInput(d)); err != nil {
+		return err
+	}
+
+	err = computing.NewSnapshotNormalWaiter(svc).Wait(ctx, expandNiftyDescribeInstanceSnapshotsInput(d), time.Until(deadline))
+	if err != nil {
+		return err
+	}
+
+	if !running {
+		return nil
+	}
+
+	if _, err := svc.StartInstances(ctx, expandStartInstancesInput(d)); err != nil {
+		return err
+	}
+
+	return computing.NewInstanceRunningWaiter(svc).Wait(ctx, describeInstancesInput, time.Until(deadline))
+}
+
+// waitUntilSnapshotExists returns the ID of the snapshot which has the name and belongs to the instance.
+// The snapshot name is unique only within an instance.
+func waitUntilSnapshotExists(ctx context.Context, d *schema.ResourceData, svc *computing.Client, timeout time.Duration) (string, error) {
+	instanceID := d.Get("instance_id").(string)
+
+	var id string
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		res, err := svc.NiftyDescribeInstanceSnapshots(ctx, expandNiftyDescribeInstanceSnapshotsInputWithName(d))
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Snapshot" {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		for _, s := range res.SnapshotInfoSet {
+			if nifcloud.ToString(s.InstanceId) == instanceID {
+				id = nifcloud.ToString(s.InstanceSnapshotId)
+				return nil
+			}
+		}
+
+		return resource.RetryableError(fmt.Errorf("expected instance snapshot %s of instance %s to be found", d.Get("snapshot_name"), instanceID))
+	})
+	return id, err
+}
@@ -0,0 +1,96 @@
+package instancesnapshot
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Provides an instance snapshot resource."
+
+// New returns the nifcloud_instance_snapshot resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+			Create:  schema.DefaultTimeout(60 * time.Minute),
+			Update:  schema.DefaultTimeout(60 * time.Minute),
+			Delete:  schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The instance name to take the snapshot of.",
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 15),
+				validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z]+$`), "Enter the instance_id within 1-15 characters [0-9a-zA-Z]."),
+			),
+		},
+		"snapshot_name": {
+			Type:        schema.TypeString,
+			Description: "The snapshot name.",
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 15),
+				validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z]+$`), "Enter the snapshot_name within 1-15 characters [0-9a-zA-Z]."),
+			),
+		},
+		"description": {
+			Type:             schema.TypeString,
+			Description:      "The snapshot description.",
+			Optional:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(0, 40),
+		},
+		"restore_trigger": {
+			Type:        schema.TypeString,
+			Description: "Changing this to a new non-empty value restores the instance from the snapshot. A running instance is stopped before restoring and started again afterwards.",
+			Optional:    true,
+		},
+		"instance_snapshot_id": {
+			Type:        schema.TypeString,
+			Description: "The instance snapshot ID.",
+			Computed:    true,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the snapshot.",
+			Computed:    true,
+		},
+		"power_status": {
+			Type:        schema.TypeString,
+			Description: "The power status of the instance when the snapshot was taken.",
+			Computed:    true,
+		},
+		"created_time": {
+			Type:        schema.TypeString,
+			Description: "The time when the snapshot was created.",
+			Computed:    true,
+		},
+		"expired_time": {
+			Type:        schema.TypeString,
+			Description: "The time when the snapshot expires.",
+			Computed:    true,
+		},
+	}
+}
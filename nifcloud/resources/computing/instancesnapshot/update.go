@@ -0,0 +1,31 @@
+package instancesnapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d.HasChange("description") {
+		input := expandNiftyModifyInstanceSnapshotAttributeInputForDescription(d)
+
+		_, err := svc.NiftyModifyInstanceSnapshotAttribute(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating instance snapshot description: %s", err))
+		}
+	}
+
+	if d.HasChange("restore_trigger") && d.Get("restore_trigger").(string) != "" {
+		if err := restore(ctx, d, svc); err != nil {
+			return diag.FromErr(fmt.Errorf("failed restoring instance from snapshot: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
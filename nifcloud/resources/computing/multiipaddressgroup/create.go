@@ -0,0 +1,31 @@
+package multiipaddressgroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandCreateMultiIPAddressGroupInput(d)
+	svc := meta.(*client.Client).Computing
+	deadline, _ := ctx.Deadline()
+
+	res, err := svc.CreateMultiIpAddressGroup(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed creating multi IP address group: %s", err))
+	}
+
+	d.SetId(nifcloud.ToString(res.MultiIpAddressGroup.MultiIpAddressGroupId))
+
+	if err := waitUntilMultiIPAddressGroupAvailable(ctx, d, svc, time.Until(deadline)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for multi IP address group available: %s", err))
+	}
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,69 @@
+package multiipaddressgroup
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandCreateMultiIPAddressGroupInput(d *schema.ResourceData) *computing.CreateMultiIpAddressGroupInput {
+	return &computing.CreateMultiIpAddressGroupInput{
+		MultiIpAddressGroupName: nifcloud.String(d.Get("name").(string)),
+		Description:             nifcloud.String(d.Get("description").(string)),
+		IpAddressCount:          nifcloud.Int32(int32(d.Get("ip_address_count").(int))),
+		Placement: &types.RequestPlacementOfCreateMultiIpAddressGroup{
+			AvailabilityZone: nifcloud.String(d.Get("availability_zone").(string)),
+		},
+	}
+}
+
+func expandDescribeMultiIPAddressGroupsInput(d *schema.ResourceData) *computing.DescribeMultiIpAddressGroupsInput {
+	return &computing.DescribeMultiIpAddressGroupsInput{
+		MultiIpAddressGroupId: []string{d.Id()},
+	}
+}
+
+func expandModifyMultiIPAddressGroupAttributeInputForName(d *schema.ResourceData) *computing.ModifyMultiIpAddressGroupAttributeInput {
+	return &computing.ModifyMultiIpAddressGroupAttributeInput{
+		MultiIpAddressGroupId:   nifcloud.String(d.Id()),
+		MultiIpAddressGroupName: nifcloud.String(d.Get("name").(string)),
+	}
+}
+
+func expandModifyMultiIPAddressGroupAttributeInputForDescription(d *schema.ResourceData) *computing.ModifyMultiIpAddressGroupAttributeInput {
+	return &computing.ModifyMultiIpAddressGroupAttributeInput{
+		MultiIpAddressGroupId: nifcloud.String(d.Id()),
+		Description:           nifcloud.String(d.Get("description").(string)),
+	}
+}
+
+func expandIncreaseMultiIPAddressCountInput(d *schema.ResourceData, count int) *computing.IncreaseMultiIpAddressCountInput {
+	return &computing.IncreaseMultiIpAddressCountInput{
+		MultiIpAddressGroupId: nifcloud.String(d.Id()),
+		IpAddressCount:        nifcloud.Int32(int32(count)),
+	}
+}
+
+// expandReleaseMultiIPAddressesInput releases the last count addresses of ip_addresses.
+func expandReleaseMultiIPAddressesInput(d *schema.ResourceData, count int) *computing.ReleaseMultiIpAddressesInput {
+	var ipAddresses []string
+	for _, ip := range d.Get("ip_addresses").([]interface{}) {
+		ipAddresses = append(ipAddresses, ip.(string))
+	}
+
+	if count > len(ipAddresses) {
+		count = len(ipAddresses)
+	}
+
+	return &computing.ReleaseMultiIpAddressesInput{
+		MultiIpAddressGroupId: nifcloud.String(d.Id()),
+		IpAddress:             ipAddresses[len(ipAddresses)-count:],
+	}
+}
+
+func expandDeleteMultiIPAddressGroupInput(d *schema.ResourceData) *computing.DeleteMultiIpAddressGroupInput {
+	return &computing.DeleteMultiIpAddressGroupInput{
+		MultiIpAddressGroupId: nifcloud.String(d.Id()),
+	}
+}
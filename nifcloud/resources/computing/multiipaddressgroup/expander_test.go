@@ -0,0 +1,216 @@
+package multiipaddressgroup
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandCreateMultiIPAddressGroupInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name":              "test_name",
+		"description":       "test_description",
+		"availability_zone": "test_availability_zone",
+		"ip_address_count":  2,
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.CreateMultiIpAddressGroupInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.CreateMultiIpAddressGroupInput{
+				MultiIpAddressGroupName: nifcloud.String("test_name"),
+				Description:             nifcloud.String("test_description"),
+				IpAddressCount:          nifcloud.Int32(2),
+				Placement: &types.RequestPlacementOfCreateMultiIpAddressGroup{
+					AvailabilityZone: nifcloud.String("test_availability_zone"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandCreateMultiIPAddressGroupInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeMultiIPAddressGroupsInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_multi_ip_address_group_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeMultiIpAddressGroupsInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeMultiIpAddressGroupsInput{
+				MultiIpAddressGroupId: []string{"test_multi_ip_address_group_id"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeMultiIPAddressGroupsInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandModifyMultiIPAddressGroupAttributeInputForName(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name": "test_name",
+	})
+	rd.SetId("test_multi_ip_address_group_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.ModifyMultiIpAddressGroupAttributeInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.ModifyMultiIpAddressGroupAttributeInput{
+				MultiIpAddressGroupId:   nifcloud.String("test_multi_ip_address_group_id"),
+				MultiIpAddressGroupName: nifcloud.String("test_name"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandModifyMultiIPAddressGroupAttributeInputForName(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandModifyMultiIPAddressGroupAttributeInputForDescription(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"description": "test_description",
+	})
+	rd.SetId("test_multi_ip_address_group_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.ModifyMultiIpAddressGroupAttributeInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.ModifyMultiIpAddressGroupAttributeInput{
+				MultiIpAddressGroupId: nifcloud.String("test_multi_ip_address_group_id"),
+				Description:           nifcloud.String("test_description"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandModifyMultiIPAddressGroupAttributeInputForDescription(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandIncreaseMultiIPAddressCountInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_multi_ip_address_group_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.IncreaseMultiIpAddressCountInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.IncreaseMultiIpAddressCountInput{
+				MultiIpAddressGroupId: nifcloud.String("test_multi_ip_address_group_id"),
+				IpAddressCount:        nifcloud.Int32(2),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandIncreaseMultiIPAddressCountInput(tt.args, 2)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandReleaseMultiIPAddressesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"ip_addresses": []interface{}{"192.0.2.1", "192.0.2.2", "192.0.2.3"},
+	})
+	rd.SetId("test_multi_ip_address_group_id")
+
+	tests := []struct {
+		name  string
+		args  *schema.ResourceData
+		count int
+		want  *computing.ReleaseMultiIpAddressesInput
+	}{
+		{
+			name:  "expands the resource data",
+			args:  rd,
+			count: 2,
+			want: &computing.ReleaseMultiIpAddressesInput{
+				MultiIpAddressGroupId: nifcloud.String("test_multi_ip_address_group_id"),
+				IpAddress:             []string{"192.0.2.2", "192.0.2.3"},
+			},
+		},
+		{
+			name:  "expands the resource data when the count exceeds the addresses",
+			args:  rd,
+			count: 5,
+			want: &computing.ReleaseMultiIpAddressesInput{
+				MultiIpAddressGroupId: nifcloud.String("test_multi_ip_address_group_id"),
+				IpAddress:             []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandReleaseMultiIPAddressesInput(tt.args, tt.count)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDeleteMultiIPAddressGroupInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_multi_ip_address_group_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DeleteMultiIpAddressGroupInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DeleteMultiIpAddressGroupInput{
+				MultiIpAddressGroupId: nifcloud.String("test_multi_ip_address_group_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDeleteMultiIPAddressGroupInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
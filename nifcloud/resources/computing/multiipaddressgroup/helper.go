@@ -0,0 +1,34 @@
+package multiipaddressgroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+const multiIPAddressGroupStatusAvailable = "available"
+
+func waitUntilMultiIPAddressGroupAvailable(ctx context.Context, d *schema.ResourceData, svc *computing.Client, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		res, err := svc.DescribeMultiIpAddressGroups(ctx, expandDescribeMultiIPAddressGroupsInput(d))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if len(res.MultiIpAddressGroupsSet) == 0 {
+			return resource.RetryableError(fmt.Errorf("expected multi IP address group %s to be found", d.Id()))
+		}
+
+		status := nifcloud.ToString(res.MultiIpAddressGroupsSet[0].Status)
+		if status == multiIPAddressGroupStatusAvailable {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("expected multi IP address group %s to be available but was in status %s", d.Id(), status))
+	})
+}
@@ -0,0 +1,84 @@
+package multiipaddressgroup
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Provides a multi IP address group resource."
+
+// New returns the nifcloud_multi_ip_address_group resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:             schema.TypeString,
+			Description:      "The name of the multi IP address group.",
+			Required:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(1, 40),
+		},
+		"description": {
+			Type:             schema.TypeString,
+			Description:      "The multi IP address group description.",
+			Optional:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(0, 40),
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone.",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+		},
+		"ip_address_count": {
+			Type:         schema.TypeInt,
+			Description:  "The number of the IP addresses in the group. When decreased, the addresses at the end of `ip_addresses` are released.",
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"multi_ip_address_group_id": {
+			Type:        schema.TypeString,
+			Description: "The multi IP address group ID.",
+			Computed:    true,
+		},
+		"ip_addresses": {
+			Type:        schema.TypeList,
+			Description: "The IP addresses in the group.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"default_gateway": {
+			Type:        schema.TypeString,
+			Description: "The default gateway of the group network.",
+			Computed:    true,
+		},
+		"subnet_mask": {
+			Type:        schema.TypeString,
+			Description: "The subnet mask of the group network.",
+			Computed:    true,
+		},
+	}
+}
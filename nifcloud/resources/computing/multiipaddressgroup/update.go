@@ -0,0 +1,57 @@
+package multiipaddressgroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+	deadline, _ := ctx.Deadline()
+
+	if d.HasChange("name") {
+		input := expandModifyMultiIPAddressGroupAttributeInputForName(d)
+
+		if _, err := svc.ModifyMultiIpAddressGroupAttribute(ctx, input); err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating multi IP address group name: %s", err))
+		}
+	}
+
+	if d.HasChange("description") {
+		input := expandModifyMultiIPAddressGroupAttributeInputForDescription(d)
+
+		if _, err := svc.ModifyMultiIpAddressGroupAttribute(ctx, input); err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating multi IP address group description: %s", err))
+		}
+	}
+
+	if d.HasChange("ip_address_count") {
+		o, n := d.GetChange("ip_address_count")
+		diff := n.(int) - o.(int)
+
+		if diff > 0 {
+			input := expandIncreaseMultiIPAddressCountInput(d, diff)
+
+			if _, err := svc.IncreaseMultiIpAddressCount(ctx, input); err != nil {
+				return diag.FromErr(fmt.Errorf("failed increasing multi IP addresses: %s", err))
+			}
+		} else {
+			input := expandReleaseMultiIPAddressesInput(d, -diff)
+
+			if _, err := svc.ReleaseMultiIpAddresses(ctx, input); err != nil {
+				return diag.FromErr(fmt.Errorf("failed releasing multi IP addresses: %s", err))
+			}
+		}
+
+		if err := waitUntilMultiIPAddressGroupAvailable(ctx, d, svc, time.Until(deadline)); err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for multi IP address group available: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
@@ -8,7 +8,8 @@ import (
 	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
 )
 
-func flatten(d *schema.ResourceData, res *computing.DescribeSecurityGroupsOutput) error {
+// Flatten sets the attributes of the security group to the resource data.
+func Flatten(d *schema.ResourceData, res *computing.DescribeSecurityGroupsOutput) error {
 	if res == nil || len(res.SecurityGroupInfo) == 0 {
 		d.SetId("")
 		return nil
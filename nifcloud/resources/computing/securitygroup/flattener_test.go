@@ -61,7 +61,7 @@ func TestFlatten(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := flatten(tt.args.d, tt.args.res)
+			err := Flatten(tt.args.d, tt.args.res)
 			assert.NoError(t, err)
 
 			if tt.args.res == nil {
@@ -36,7 +36,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Di
 		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
 	}
 
-	if err := flatten(d, res); err != nil {
+	if err := Flatten(d, res); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
@@ -127,3 +127,43 @@ func flatten(d *schema.ResourceData, res *computing.DescribeSecurityGroupsOutput
 
 	return nil
 }
+
+// FlattenRules returns the permissions in the form of the nifcloud_security_group_rule attributes.
+// A permission with several sources is expanded to one rule per source.
+func FlattenRules(permissions []types.IpPermissions) []map[string]interface{} {
+	rules := []map[string]interface{}{}
+	for _, p := range permissions {
+		rule := map[string]interface{}{
+			"type":        nifcloud.ToString(p.InOut),
+			"protocol":    nifcloud.ToString(p.IpProtocol),
+			"from_port":   nifcloud.ToInt32(p.FromPort),
+			"to_port":     nifcloud.ToInt32(p.ToPort),
+			"description": nifcloud.ToString(p.Description),
+		}
+
+		for _, ip := range p.IpRanges {
+			r := copyRule(rule)
+			r["cidr_ip"] = nifcloud.ToString(ip.CidrIp)
+			rules = append(rules, r)
+		}
+
+		for _, g := range p.Groups {
+			r := copyRule(rule)
+			r["source_security_group_name"] = nifcloud.ToString(g.GroupName)
+			rules = append(rules, r)
+		}
+
+		if len(p.IpRanges) == 0 && len(p.Groups) == 0 {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func copyRule(rule map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(rule)+1)
+	for k, v := range rule {
+		res[k] = v
+	}
+	return res
+}
@@ -107,3 +107,72 @@ func TestFlatten(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []types.IpPermissions
+		want        []map[string]interface{}
+	}{
+		{
+			name: "expands the permission to one rule per source",
+			permissions: []types.IpPermissions{
+				{
+					InOut:       nifcloud.String("IN"),
+					IpProtocol:  nifcloud.String("TCP"),
+					FromPort:    nifcloud.Int32(80),
+					ToPort:      nifcloud.Int32(443),
+					Description: nifcloud.String("test_description"),
+					IpRanges: []types.IpRanges{
+						{CidrIp: nifcloud.String("192.0.2.0/24")},
+					},
+					Groups: []types.Groups{
+						{GroupName: nifcloud.String("test_source_group")},
+					},
+				},
+			},
+			want: []map[string]interface{}{
+				{
+					"type":        "IN",
+					"protocol":    "TCP",
+					"from_port":   int32(80),
+					"to_port":     int32(443),
+					"description": "test_description",
+					"cidr_ip":     "192.0.2.0/24",
+				},
+				{
+					"type":                       "IN",
+					"protocol":                   "TCP",
+					"from_port":                  int32(80),
+					"to_port":                    int32(443),
+					"description":                "test_description",
+					"source_security_group_name": "test_source_group",
+				},
+			},
+		},
+		{
+			name: "flattens the permission without sources",
+			permissions: []types.IpPermissions{
+				{
+					InOut:      nifcloud.String("OUT"),
+					IpProtocol: nifcloud.String("ANY"),
+				},
+			},
+			want: []map[string]interface{}{
+				{
+					"type":        "OUT",
+					"protocol":    "ANY",
+					"from_port":   int32(0),
+					"to_port":     int32(0),
+					"description": "",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FlattenRules(tt.permissions))
+		})
+	}
+}
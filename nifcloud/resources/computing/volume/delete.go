@@ -17,21 +17,35 @@ func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	svc := meta.(*client.Client).Computing
 	deadline, _ := ctx.Deadline()
 
-	detachVolumeInput := expandDetachVolumeInput(d)
-	_, err := svc.DetachVolume(ctx, detachVolumeInput)
+	describeVolumeInput := expandDescribeVolumesInput(d)
+	res, err := svc.DescribeVolumes(ctx, describeVolumeInput)
 	if err != nil {
 		var awsErr smithy.APIError
 		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Volume" {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("failed detaching volume: %s", err))
+		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
 	}
 
-	describeVolumeInput := expandDescribeVolumesInput(d)
-	err = computing.NewVolumeAvailableWaiter(svc).Wait(ctx, describeVolumeInput, time.Until(deadline))
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed waiting for volume detached: %s", err))
+	// The volume may have been detached already, e.g. by nifcloud_volume_attachment.
+	if len(res.VolumeSet) != 0 && len(res.VolumeSet[0].AttachmentSet) != 0 {
+		detachVolumeInput := expandDetachVolumeInput(d)
+		detachVolumeInput.InstanceId = res.VolumeSet[0].AttachmentSet[0].InstanceId
+		_, err := svc.DetachVolume(ctx, detachVolumeInput)
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Volume" {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(fmt.Errorf("failed detaching volume: %s", err))
+		}
+
+		err = computing.NewVolumeAvailableWaiter(svc).Wait(ctx, describeVolumeInput, time.Until(deadline))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for volume detached: %s", err))
+		}
 	}
 
 	deleteVolumeInput := expandDeleteVolumeInput(d)
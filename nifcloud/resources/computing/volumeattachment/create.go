@@ -0,0 +1,41 @@
+package volumeattachment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandAttachVolumeInput(d)
+	svc := meta.(*client.Client).Computing
+	deadline, _ := ctx.Deadline()
+
+	d.SetId(d.Get("volume_id").(string))
+
+	res, err := svc.DescribeVolumes(ctx, expandDescribeVolumesInput(d))
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("failed reading volume: %s", err))
+	}
+
+	// CreateVolume needs an instance, so the volume may already be attached to the instance.
+	if !isAttachedTo(res, d.Get("instance_id").(string)) {
+		if _, err := svc.AttachVolume(ctx, input); err != nil {
+			d.SetId("")
+			return diag.FromErr(fmt.Errorf("failed attaching volume: %s", err))
+		}
+	}
+
+	err = computing.NewVolumeInUseWaiter(svc).Wait(ctx, expandDescribeVolumesInput(d), time.Until(deadline))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for volume attached: %s", err))
+	}
+
+	return read(ctx, d, meta)
+}
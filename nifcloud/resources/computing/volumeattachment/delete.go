@@ -0,0 +1,37 @@
+package volumeattachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandDetachVolumeInput(d)
+	svc := meta.(*client.Client).Computing
+	deadline, _ := ctx.Deadline()
+
+	if _, err := svc.DetachVolume(ctx, input); err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Volume" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed detaching volume: %s", err))
+	}
+
+	err := computing.NewVolumeAvailableWaiter(svc).Wait(ctx, expandDescribeVolumesInput(d), time.Until(deadline))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for volume detached: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
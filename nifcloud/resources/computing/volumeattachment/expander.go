@@ -0,0 +1,29 @@
+package volumeattachment
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func expandAttachVolumeInput(d *schema.ResourceData) *computing.AttachVolumeInput {
+	return &computing.AttachVolumeInput{
+		VolumeId:   nifcloud.String(d.Get("volume_id").(string)),
+		InstanceId: nifcloud.String(d.Get("instance_id").(string)),
+	}
+}
+
+func expandDescribeVolumesInput(d *schema.ResourceData) *computing.DescribeVolumesInput {
+	return &computing.DescribeVolumesInput{
+		VolumeId: []string{d.Id()},
+	}
+}
+
+func expandDetachVolumeInput(d *schema.ResourceData) *computing.DetachVolumeInput {
+	return &computing.DetachVolumeInput{
+		VolumeId:   nifcloud.String(d.Id()),
+		InstanceId: nifcloud.String(d.Get("instance_id").(string)),
+		Force:      nifcloud.Bool(d.Get("force_detach").(bool)),
+		Agreement:  nifcloud.Bool(d.Get("agreement").(bool)),
+	}
+}
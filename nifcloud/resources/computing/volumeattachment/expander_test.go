@@ -0,0 +1,95 @@
+package volumeattachment
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandAttachVolumeInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"volume_id":   "test_volume_id",
+		"instance_id": "test_instance_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.AttachVolumeInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.AttachVolumeInput{
+				VolumeId:   nifcloud.String("test_volume_id"),
+				InstanceId: nifcloud.String("test_instance_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandAttachVolumeInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeVolumesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_volume_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeVolumesInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeVolumesInput{
+				VolumeId: []string{"test_volume_id"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeVolumesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDetachVolumeInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id":  "test_instance_id",
+		"force_detach": true,
+		"agreement":    true,
+	})
+	rd.SetId("test_volume_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DetachVolumeInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DetachVolumeInput{
+				VolumeId:   nifcloud.String("test_volume_id"),
+				InstanceId: nifcloud.String("test_instance_id"),
+				Force:      nifcloud.Bool(true),
+				Agreement:  nifcloud.Bool(true),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDetachVolumeInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
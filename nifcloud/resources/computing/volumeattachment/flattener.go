@@ -0,0 +1,44 @@
+package volumeattachment
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeVolumesOutput) error {
+	if res == nil || len(res.VolumeSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	volume := res.VolumeSet[0]
+
+	if nifcloud.ToString(volume.VolumeId) != d.Id() {
+		return fmt.Errorf("unable to find volume within: %#v", res.VolumeSet)
+	}
+
+	// The attachment has gone when the volume has been detached externally.
+	if len(volume.AttachmentSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	attachment := volume.AttachmentSet[0]
+
+	if err := d.Set("volume_id", volume.VolumeId); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_id", attachment.InstanceId); err != nil {
+		return err
+	}
+
+	if err := d.Set("device", attachment.Device); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package volumeattachment
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"volume_id":   "test_volume_id",
+		"instance_id": "test_instance_id",
+		"device":      "test_device",
+	})
+	rd.SetId("test_volume_id")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantDetachedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	wantDetachedRd.SetId("test_volume_id")
+
+	type args struct {
+		res *computing.DescribeVolumesOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.DescribeVolumesOutput{
+					VolumeSet: []types.VolumeSet{
+						{
+							VolumeId: nifcloud.String("test_volume_id"),
+							AttachmentSet: []types.AttachmentSet{
+								{
+									InstanceId: nifcloud.String("test_instance_id"),
+									Device:     nifcloud.String("test_device"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.DescribeVolumesOutput{
+					VolumeSet: []types.VolumeSet{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the volume has been detached externally",
+			args: args{
+				d: wantDetachedRd,
+				res: &computing.DescribeVolumesOutput{
+					VolumeSet: []types.VolumeSet{
+						{
+							VolumeId: nifcloud.String("test_volume_id"),
+						},
+					},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
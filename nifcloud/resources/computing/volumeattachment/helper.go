@@ -0,0 +1,19 @@
+package volumeattachment
+
+import (
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func isAttachedTo(res *computing.DescribeVolumesOutput, instanceID string) bool {
+	if res == nil || len(res.VolumeSet) == 0 {
+		return false
+	}
+
+	for _, a := range res.VolumeSet[0].AttachmentSet {
+		if nifcloud.ToString(a.InstanceId) == instanceID {
+			return true
+		}
+	}
+	return false
+}
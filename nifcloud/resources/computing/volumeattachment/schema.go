@@ -0,0 +1,63 @@
+package volumeattachment
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Provides a volume attachment resource."
+
+// New returns the nifcloud_volume_attachment resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: schema.NoopContext,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"volume_id": {
+			Type:        schema.TypeString,
+			Description: "The volume name to attach.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The instance name to attach the volume to.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"force_detach": {
+			Type:        schema.TypeBool,
+			Description: "If true, the volume is detached forcibly on destroy.",
+			Optional:    true,
+			Default:     false,
+		},
+		"agreement": {
+			Type:        schema.TypeBool,
+			Description: "The flag to agree to the notice of detaching the volume, which is sent on destroy.",
+			Optional:    true,
+			Default:     false,
+		},
+		"device": {
+			Type:        schema.TypeString,
+			Description: "The device name of the volume on the instance.",
+			Computed:    true,
+		},
+	}
+}
@@ -9,7 +9,8 @@ import (
 	"github.com/nifcloud/nifcloud-sdk-go/service/hatoba/types"
 )
 
-func flatten(d *schema.ResourceData, res *hatoba.GetClusterOutput) error {
+// Flatten sets the attributes of the Hatoba cluster to the resource data.
+func Flatten(d *schema.ResourceData, res *hatoba.GetClusterOutput) error {
 	if res == nil {
 		d.SetId("")
 		return nil
@@ -110,6 +111,7 @@ func flattenNodes(nodes []types.Nodes) []map[string]interface{} {
 	return res
 }
 
-func flattenCredentials(d *schema.ResourceData, res *hatoba.GetClusterCredentialsOutput) error {
+// FlattenCredentials sets the raw Kubernetes config to the resource data.
+func FlattenCredentials(d *schema.ResourceData, res *hatoba.GetClusterCredentialsOutput) error {
 	return d.Set("kube_config_raw", res.Credentials)
 }
@@ -127,7 +127,7 @@ func TestFlatten(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := flatten(tt.args.d, tt.args.res)
+			err := Flatten(tt.args.d, tt.args.res)
 			assert.NoError(t, err)
 
 			if tt.args.res == nil {
@@ -180,7 +180,7 @@ func TestFlattenCredentials(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := flattenCredentials(tt.args.d, tt.args.res)
+			err := FlattenCredentials(tt.args.d, tt.args.res)
 			assert.NoError(t, err)
 
 			if tt.args.res == nil {
@@ -25,7 +25,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Di
 		return diag.FromErr(fmt.Errorf("failed reading Hatoba cluster: %s", err))
 	}
 
-	if err := flatten(d, res); err != nil {
+	if err := Flatten(d, res); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -37,7 +37,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Di
 		return diag.FromErr(fmt.Errorf("failed reading Hatoba cluster credentials: %s", err))
 	}
 
-	if err := flattenCredentials(d, getClusterCredentialsRes); err != nil {
+	if err := FlattenCredentials(d, getClusterCredentialsRes); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -0,0 +1,105 @@
+package dhcpconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/cidr"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/rawconfig"
+)
+
+type ipAddressPool struct {
+	start string
+	stop  string
+}
+
+type staticMapping struct {
+	ipAddress  string
+	macAddress string
+}
+
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+
+	if d.HasChange("ipaddress_pool") {
+		var pools []ipAddressPool
+		for _, p := range rawconfig.Elements(config.GetAttr("ipaddress_pool")) {
+			start, _ := rawconfig.String(p, "ipaddress_pool_start")
+			stop, _ := rawconfig.String(p, "ipaddress_pool_stop")
+			pools = append(pools, ipAddressPool{start: start, stop: stop})
+		}
+
+		if err := validateIPAddressPools(pools); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("static_mapping") {
+		var mappings []staticMapping
+		for _, m := range rawconfig.Elements(config.GetAttr("static_mapping")) {
+			ipAddress, _ := rawconfig.String(m, "static_mapping_ipaddress")
+			macAddress, _ := rawconfig.String(m, "static_mapping_macaddress")
+			mappings = append(mappings, staticMapping{ipAddress: ipAddress, macAddress: macAddress})
+		}
+
+		if err := validateStaticMappings(mappings); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateIPAddressPools(pools []ipAddressPool) error {
+	for i, p := range pools {
+		if p.start == "" || p.stop == "" {
+			continue
+		}
+
+		if cidr.CompareIPAddress(p.start, p.stop) > 0 {
+			return fmt.Errorf(
+				"ipaddress_pool.%d.ipaddress_pool_start: %q must not be greater than ipaddress_pool_stop %q",
+				i, p.start, p.stop,
+			)
+		}
+
+		for _, other := range pools[:i] {
+			if other.start == "" || other.stop == "" {
+				continue
+			}
+
+			if cidr.Overlaps(p.start, p.stop, other.start, other.stop) {
+				return fmt.Errorf(
+					"ipaddress_pool.%d: the range %s-%s overlaps with the range %s-%s",
+					i, p.start, p.stop, other.start, other.stop,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+func validateStaticMappings(mappings []staticMapping) error {
+	ipAddresses := make(map[string]bool, len(mappings))
+	macAddresses := make(map[string]bool, len(mappings))
+
+	for i, m := range mappings {
+		if m.ipAddress != "" {
+			if ipAddresses[m.ipAddress] {
+				return fmt.Errorf("static_mapping.%d.static_mapping_ipaddress: %q is duplicated", i, m.ipAddress)
+			}
+			ipAddresses[m.ipAddress] = true
+		}
+
+		if m.macAddress != "" {
+			macAddress := strings.ToLower(m.macAddress)
+			if macAddresses[macAddress] {
+				return fmt.Errorf("static_mapping.%d.static_mapping_macaddress: %q is duplicated", i, m.macAddress)
+			}
+			macAddresses[macAddress] = true
+		}
+	}
+	return nil
+}
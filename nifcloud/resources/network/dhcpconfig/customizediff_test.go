@@ -0,0 +1,106 @@
+package dhcpconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIPAddressPools(t *testing.T) {
+	tests := []struct {
+		name    string
+		pools   []ipAddressPool
+		wantErr string
+	}{
+		{
+			name: "accepts the separated pools",
+			pools: []ipAddressPool{
+				{start: "192.168.1.10", stop: "192.168.1.20"},
+				{start: "192.168.1.21", stop: "192.168.1.30"},
+			},
+		},
+		{
+			name: "returns the path of the pool whose start is greater than stop",
+			pools: []ipAddressPool{
+				{start: "192.168.1.20", stop: "192.168.1.10"},
+			},
+			wantErr: `ipaddress_pool.0.ipaddress_pool_start: "192.168.1.20" must not be greater than ipaddress_pool_stop "192.168.1.10"`,
+		},
+		{
+			name: "returns the path of the overlapped pool",
+			pools: []ipAddressPool{
+				{start: "192.168.1.10", stop: "192.168.1.20"},
+				{start: "192.168.1.15", stop: "192.168.1.30"},
+			},
+			wantErr: "ipaddress_pool.1: the range 192.168.1.15-192.168.1.30 overlaps with the range 192.168.1.10-192.168.1.20",
+		},
+		{
+			name: "ignores the unknown pools",
+			pools: []ipAddressPool{
+				{start: "", stop: "192.168.1.20"},
+				{start: "192.168.1.15", stop: "192.168.1.30"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIPAddressPools(tt.pools)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateStaticMappings(t *testing.T) {
+	tests := []struct {
+		name     string
+		mappings []staticMapping
+		wantErr  string
+	}{
+		{
+			name: "accepts the unique mappings",
+			mappings: []staticMapping{
+				{ipAddress: "192.168.1.10", macAddress: "00:00:5e:00:53:01"},
+				{ipAddress: "192.168.1.11", macAddress: "00:00:5e:00:53:02"},
+			},
+		},
+		{
+			name: "returns the path of the duplicated ip address",
+			mappings: []staticMapping{
+				{ipAddress: "192.168.1.10", macAddress: "00:00:5e:00:53:01"},
+				{ipAddress: "192.168.1.10", macAddress: "00:00:5e:00:53:02"},
+			},
+			wantErr: `static_mapping.1.static_mapping_ipaddress: "192.168.1.10" is duplicated`,
+		},
+		{
+			name: "returns the path of the duplicated mac address",
+			mappings: []staticMapping{
+				{ipAddress: "192.168.1.10", macAddress: "00:00:5e:00:53:01"},
+				{ipAddress: "192.168.1.11", macAddress: "00:00:5E:00:53:01"},
+			},
+			wantErr: `static_mapping.1.static_mapping_macaddress: "00:00:5E:00:53:01" is duplicated`,
+		},
+		{
+			name: "ignores the unknown values",
+			mappings: []staticMapping{
+				{ipAddress: "", macAddress: ""},
+				{ipAddress: "", macAddress: ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateStaticMappings(tt.mappings)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
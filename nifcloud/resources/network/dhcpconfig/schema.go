@@ -20,6 +20,8 @@ func New() *schema.Resource {
 		UpdateContext: update,
 		DeleteContext: delete,
 
+		CustomizeDiff: customizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -159,7 +159,7 @@ func newSchema() map[string]*schema.Schema {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description: "A list of instance names to place in the multi load balancer pool.",
+			Description: "A list of instance names to place in the multi load balancer pool. Add this to `ignore_changes` when `nifcloud_elb_instance_attachment` is used for the same listener.",
 			Optional:    true,
 		},
 		"network_interface": {
@@ -225,7 +225,7 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"route_table_id": {
 			Type:        schema.TypeString,
-			Description: "The id of route table to attach.",
+			Description: "The id of route table to attach. Add this to `ignore_changes` when `nifcloud_route_table_association` is used for the elb.",
 			Optional:    true,
 		},
 		"route_table_association_id": {
@@ -0,0 +1,50 @@
+package elbinstanceattachment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if diags := register(ctx, d, svc); diags != nil {
+		return diags
+	}
+
+	if d.Get("wait_for_healthy").(bool) {
+		if err := waitUntilInstanceInService(ctx, d, svc); err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for instance to become healthy: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
+
+func register(ctx context.Context, d *schema.ResourceData, svc *computing.Client) diag.Diagnostics {
+	elbID := d.Get("elb_id").(string)
+	mutexkv.LockELB(elbID)
+	defer mutexkv.UnlockELB(elbID)
+
+	if err := waitUntilELBAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed wait until elb available: %s", err))
+	}
+
+	if _, err := svc.NiftyRegisterInstancesWithElasticLoadBalancer(ctx, expandNiftyRegisterInstancesWithElasticLoadBalancerInput(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed registering instance with elb: %s", err))
+	}
+
+	d.SetId(buildID(d))
+
+	if err := waitUntilELBAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed wait until elb available: %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package elbinstanceattachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandNiftyDeregisterInstancesFromElasticLoadBalancerInput(d)
+	svc := meta.(*client.Client).Computing
+
+	elbID := d.Get("elb_id").(string)
+	mutexkv.LockELB(elbID)
+	defer mutexkv.UnlockELB(elbID)
+
+	if err := waitUntilELBAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed wait until elb available: %s", err))
+	}
+
+	if _, err := svc.NiftyDeregisterInstancesFromElasticLoadBalancer(ctx, input); err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.ElasticLoadBalancerId" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed deregistering instance from elb: %s", err))
+	}
+
+	if err := waitUntilELBAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed wait until elb available: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
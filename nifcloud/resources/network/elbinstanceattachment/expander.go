@@ -0,0 +1,61 @@
+package elbinstanceattachment
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandNiftyRegisterInstancesWithElasticLoadBalancerInput(d *schema.ResourceData) *computing.NiftyRegisterInstancesWithElasticLoadBalancerInput {
+	return &computing.NiftyRegisterInstancesWithElasticLoadBalancerInput{
+		ElasticLoadBalancerId:   nifcloud.String(d.Get("elb_id").(string)),
+		ElasticLoadBalancerPort: nifcloud.Int32(int32(d.Get("lb_port").(int))),
+		InstancePort:            nifcloud.Int32(int32(d.Get("instance_port").(int))),
+		Protocol:                types.ProtocolOfNiftyRegisterInstancesWithElasticLoadBalancerRequest(d.Get("protocol").(string)),
+		Instances: &types.ListOfRequestInstancesOfNiftyRegisterInstancesWithElasticLoadBalancer{
+			Member: []types.RequestInstancesOfNiftyRegisterInstancesWithElasticLoadBalancer{
+				{InstanceId: nifcloud.String(d.Get("instance_id").(string))},
+			},
+		},
+	}
+}
+
+func expandNiftyDeregisterInstancesFromElasticLoadBalancerInput(d *schema.ResourceData) *computing.NiftyDeregisterInstancesFromElasticLoadBalancerInput {
+	return &computing.NiftyDeregisterInstancesFromElasticLoadBalancerInput{
+		ElasticLoadBalancerId:   nifcloud.String(d.Get("elb_id").(string)),
+		ElasticLoadBalancerPort: nifcloud.Int32(int32(d.Get("lb_port").(int))),
+		InstancePort:            nifcloud.Int32(int32(d.Get("instance_port").(int))),
+		Protocol:                types.ProtocolOfNiftyDeregisterInstancesFromElasticLoadBalancerRequest(d.Get("protocol").(string)),
+		Instances: &types.ListOfRequestInstancesOfNiftyDeregisterInstancesFromElasticLoadBalancer{
+			Member: []types.RequestInstancesOfNiftyDeregisterInstancesFromElasticLoadBalancer{
+				{InstanceId: nifcloud.String(d.Get("instance_id").(string))},
+			},
+		},
+	}
+}
+
+func expandNiftyDescribeElasticLoadBalancersInput(d *schema.ResourceData) *computing.NiftyDescribeElasticLoadBalancersInput {
+	return &computing.NiftyDescribeElasticLoadBalancersInput{
+		ElasticLoadBalancers: &types.RequestElasticLoadBalancers{
+			ListOfRequestElasticLoadBalancerId:   []string{d.Get("elb_id").(string)},
+			ListOfRequestElasticLoadBalancerPort: []int32{int32(d.Get("lb_port").(int))},
+			ListOfRequestInstancePort:            []int32{int32(d.Get("instance_port").(int))},
+			ListOfRequestProtocol:                []string{d.Get("protocol").(string)},
+		},
+	}
+}
+
+func expandNiftyDescribeInstanceElasticLoadBalancerHealthInput(d *schema.ResourceData) *computing.NiftyDescribeInstanceElasticLoadBalancerHealthInput {
+	return &computing.NiftyDescribeInstanceElasticLoadBalancerHealthInput{
+		ElasticLoadBalancerId:   nifcloud.String(d.Get("elb_id").(string)),
+		ElasticLoadBalancerPort: nifcloud.Int32(int32(d.Get("lb_port").(int))),
+		InstancePort:            nifcloud.Int32(int32(d.Get("instance_port").(int))),
+		Protocol:                types.ProtocolOfNiftyDescribeInstanceElasticLoadBalancerHealthRequest(d.Get("protocol").(string)),
+		Instances: &types.ListOfRequestInstancesOfNiftyDescribeInstanceElasticLoadBalancerHealth{
+			Member: []types.RequestInstancesOfNiftyDescribeInstanceElasticLoadBalancerHealth{
+				{InstanceId: nifcloud.String(d.Get("instance_id").(string))},
+			},
+		},
+	}
+}
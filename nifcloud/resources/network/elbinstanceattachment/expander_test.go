@@ -0,0 +1,159 @@
+package elbinstanceattachment
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandNiftyRegisterInstancesWithElasticLoadBalancerInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"elb_id":        "test_elb_id",
+		"protocol":      "HTTP",
+		"lb_port":       80,
+		"instance_port": 8080,
+		"instance_id":   "test_instance_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyRegisterInstancesWithElasticLoadBalancerInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyRegisterInstancesWithElasticLoadBalancerInput{
+				ElasticLoadBalancerId:   nifcloud.String("test_elb_id"),
+				ElasticLoadBalancerPort: nifcloud.Int32(80),
+				InstancePort:            nifcloud.Int32(8080),
+				Protocol:                types.ProtocolOfNiftyRegisterInstancesWithElasticLoadBalancerRequestHttp,
+				Instances: &types.ListOfRequestInstancesOfNiftyRegisterInstancesWithElasticLoadBalancer{
+					Member: []types.RequestInstancesOfNiftyRegisterInstancesWithElasticLoadBalancer{
+						{InstanceId: nifcloud.String("test_instance_id")},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyRegisterInstancesWithElasticLoadBalancerInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDeregisterInstancesFromElasticLoadBalancerInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"elb_id":        "test_elb_id",
+		"protocol":      "HTTP",
+		"lb_port":       80,
+		"instance_port": 8080,
+		"instance_id":   "test_instance_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDeregisterInstancesFromElasticLoadBalancerInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDeregisterInstancesFromElasticLoadBalancerInput{
+				ElasticLoadBalancerId:   nifcloud.String("test_elb_id"),
+				ElasticLoadBalancerPort: nifcloud.Int32(80),
+				InstancePort:            nifcloud.Int32(8080),
+				Protocol:                types.ProtocolOfNiftyDeregisterInstancesFromElasticLoadBalancerRequestHttp,
+				Instances: &types.ListOfRequestInstancesOfNiftyDeregisterInstancesFromElasticLoadBalancer{
+					Member: []types.RequestInstancesOfNiftyDeregisterInstancesFromElasticLoadBalancer{
+						{InstanceId: nifcloud.String("test_instance_id")},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDeregisterInstancesFromElasticLoadBalancerInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeElasticLoadBalancersInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"elb_id":        "test_elb_id",
+		"protocol":      "HTTP",
+		"lb_port":       80,
+		"instance_port": 8080,
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeElasticLoadBalancersInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeElasticLoadBalancersInput{
+				ElasticLoadBalancers: &types.RequestElasticLoadBalancers{
+					ListOfRequestElasticLoadBalancerId:   []string{"test_elb_id"},
+					ListOfRequestElasticLoadBalancerPort: []int32{80},
+					ListOfRequestInstancePort:            []int32{8080},
+					ListOfRequestProtocol:                []string{"HTTP"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeElasticLoadBalancersInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeInstanceElasticLoadBalancerHealthInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"elb_id":        "test_elb_id",
+		"protocol":      "HTTP",
+		"lb_port":       80,
+		"instance_port": 8080,
+		"instance_id":   "test_instance_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeInstanceElasticLoadBalancerHealthInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeInstanceElasticLoadBalancerHealthInput{
+				ElasticLoadBalancerId:   nifcloud.String("test_elb_id"),
+				ElasticLoadBalancerPort: nifcloud.Int32(80),
+				InstancePort:            nifcloud.Int32(8080),
+				Protocol:                types.ProtocolOfNiftyDescribeInstanceElasticLoadBalancerHealthRequestHttp,
+				Instances: &types.ListOfRequestInstancesOfNiftyDescribeInstanceElasticLoadBalancerHealth{
+					Member: []types.RequestInstancesOfNiftyDescribeInstanceElasticLoadBalancerHealth{
+						{InstanceId: nifcloud.String("test_instance_id")},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeInstanceElasticLoadBalancerHealthInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package elbinstanceattachment
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.NiftyDescribeElasticLoadBalancersOutput) error {
+	if res == nil || len(res.NiftyDescribeElasticLoadBalancersResult.ElasticLoadBalancerDescriptions) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	elb := res.NiftyDescribeElasticLoadBalancersResult.ElasticLoadBalancerDescriptions[0]
+
+	if nifcloud.ToString(elb.ElasticLoadBalancerId) != d.Get("elb_id").(string) {
+		return fmt.Errorf(
+			"unable to find elb within: %#v",
+			res.NiftyDescribeElasticLoadBalancersResult.ElasticLoadBalancerDescriptions,
+		)
+	}
+
+	if len(elb.ElasticLoadBalancerListenerDescriptions) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	listener := elb.ElasticLoadBalancerListenerDescriptions[0].Listener
+
+	for _, instance := range listener.Instances {
+		if nifcloud.ToString(instance.InstanceId) == d.Get("instance_id").(string) {
+			return d.Set("instance_id", instance.InstanceId)
+		}
+	}
+
+	// The attachment has gone when the instance has been deregistered externally.
+	d.SetId("")
+	return nil
+}
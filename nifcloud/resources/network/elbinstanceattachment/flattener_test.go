@@ -0,0 +1,140 @@
+package elbinstanceattachment
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"elb_id":        "test_elb_id",
+		"protocol":      "HTTP",
+		"lb_port":       80,
+		"instance_port": 8080,
+		"instance_id":   "test_instance_id",
+	})
+	rd.SetId("test_elb_id_HTTP_80_8080_test_instance_id")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantDeregisteredRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"elb_id":        "test_elb_id",
+		"protocol":      "HTTP",
+		"lb_port":       80,
+		"instance_port": 8080,
+		"instance_id":   "test_instance_id",
+	})
+	wantDeregisteredRd.SetId("test_elb_id_HTTP_80_8080_test_instance_id")
+
+	wantDeregisteredStateRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"elb_id":        "test_elb_id",
+		"protocol":      "HTTP",
+		"lb_port":       80,
+		"instance_port": 8080,
+		"instance_id":   "test_instance_id",
+	})
+
+	type args struct {
+		res *computing.NiftyDescribeElasticLoadBalancersOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeElasticLoadBalancersOutput{
+					NiftyDescribeElasticLoadBalancersResult: &types.NiftyDescribeElasticLoadBalancersResult{
+						ElasticLoadBalancerDescriptions: []types.ElasticLoadBalancerDescriptions{
+							{
+								ElasticLoadBalancerId: nifcloud.String("test_elb_id"),
+								ElasticLoadBalancerListenerDescriptions: []types.ElasticLoadBalancerListenerDescriptions{
+									{
+										Listener: &types.ListenerOfNiftyDescribeElasticLoadBalancers{
+											Instances: []types.Instances{
+												{InstanceId: nifcloud.String("test_other_instance_id")},
+												{InstanceId: nifcloud.String("test_instance_id")},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeElasticLoadBalancersOutput{
+					NiftyDescribeElasticLoadBalancersResult: &types.NiftyDescribeElasticLoadBalancersResult{
+						ElasticLoadBalancerDescriptions: []types.ElasticLoadBalancerDescriptions{},
+					},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the instance has been deregistered externally",
+			args: args{
+				d: wantDeregisteredRd,
+				res: &computing.NiftyDescribeElasticLoadBalancersOutput{
+					NiftyDescribeElasticLoadBalancersResult: &types.NiftyDescribeElasticLoadBalancersResult{
+						ElasticLoadBalancerDescriptions: []types.ElasticLoadBalancerDescriptions{
+							{
+								ElasticLoadBalancerId: nifcloud.String("test_elb_id"),
+								ElasticLoadBalancerListenerDescriptions: []types.ElasticLoadBalancerListenerDescriptions{
+									{
+										Listener: &types.ListenerOfNiftyDescribeElasticLoadBalancers{
+											Instances: []types.Instances{
+												{InstanceId: nifcloud.String("test_other_instance_id")},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: wantDeregisteredStateRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package elbinstanceattachment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+const instanceStateInService = "InService"
+
+func validateImportString(importStr string) ([]string, error) {
+	// example: example_TCP_8000_8000_web001
+
+	importParts := strings.Split(importStr, "_")
+	errStr := "unexpected format of import string (%q), expected ELBID_PROTOCOL_LBPORT_INSTANCEPORT_INSTANCEID: %s"
+	if len(importParts) != 5 {
+		return nil, fmt.Errorf(errStr, importStr, "invalid parts")
+	}
+
+	elbID := importParts[0]
+	protocol := importParts[1]
+	lbPort := importParts[2]
+	instancePort := importParts[3]
+	instanceID := importParts[4]
+
+	if elbID == "" {
+		return nil, fmt.Errorf(errStr, importStr, "elb id must be required")
+	}
+
+	if protocol != "TCP" &&
+		protocol != "UDP" &&
+		protocol != "HTTP" &&
+		protocol != "HTTPS" {
+		return nil, fmt.Errorf(errStr, importStr, "protocol must be TCP/UDP/HTTP/HTTPS")
+	}
+
+	if _, err := strconv.Atoi(lbPort); err != nil {
+		return nil, fmt.Errorf(errStr, importStr, "invalid lb port")
+	}
+	if _, err := strconv.Atoi(instancePort); err != nil {
+		return nil, fmt.Errorf(errStr, importStr, "invalid instance port")
+	}
+
+	if instanceID == "" {
+		return nil, fmt.Errorf(errStr, importStr, "instance id must be required")
+	}
+	return importParts, nil
+}
+
+func populateFromImport(d *schema.ResourceData, importParts []string) error {
+	elbID := importParts[0]
+	protocol := importParts[1]
+	lbPort := importParts[2]
+	instancePort := importParts[3]
+	instanceID := importParts[4]
+
+	if err := d.Set("elb_id", elbID); err != nil {
+		return err
+	}
+
+	if err := d.Set("protocol", protocol); err != nil {
+		return err
+	}
+
+	p, err := strconv.Atoi(lbPort)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("lb_port", p); err != nil {
+		return err
+	}
+
+	p, err = strconv.Atoi(instancePort)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_port", p); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_id", instanceID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func buildID(d *schema.ResourceData) string {
+	return strings.Join([]string{
+		d.Get("elb_id").(string),
+		d.Get("protocol").(string),
+		strconv.Itoa(d.Get("lb_port").(int)),
+		strconv.Itoa(d.Get("instance_port").(int)),
+		d.Get("instance_id").(string),
+	}, "_")
+}
+
+func waitUntilELBAvailable(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	deadline, _ := ctx.Deadline()
+
+	return computing.NewElasticLoadBalancerAvailableWaiter(svc).Wait(ctx, expandNiftyDescribeElasticLoadBalancersInput(d), time.Until(deadline))
+}
+
+func waitUntilInstanceInService(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	deadline, _ := ctx.Deadline()
+
+	return resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
+		res, err := svc.NiftyDescribeInstanceElasticLoadBalancerHealth(ctx, expandNiftyDescribeInstanceElasticLoadBalancerHealthInput(d))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, s := range res.NiftyDescribeInstanceElasticLoadBalancerHealthResult.InstanceStates {
+			if nifcloud.ToString(s.InstanceId) != d.Get("instance_id").(string) {
+				continue
+			}
+
+			state := nifcloud.ToString(s.State)
+			if state == instanceStateInService {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("expected instance %s to be InService but was in state %s", d.Get("instance_id"), state))
+		}
+
+		return resource.RetryableError(fmt.Errorf("expected health of instance %s to be found", d.Get("instance_id")))
+	})
+}
@@ -0,0 +1,84 @@
+package elbinstanceattachment
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Provides a multi load balancer instance attachment resource."
+
+// New returns the nifcloud_elb_instance_attachment resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: schema.NoopContext,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importParts, err := validateImportString(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				if err := populateFromImport(d, importParts); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"elb_id": {
+			Type:        schema.TypeString,
+			Description: "The id of multi load balancer.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"protocol": {
+			Type:         schema.TypeString,
+			Description:  "The protocol of the listener. Valid values are `HTTP` `HTTPS` `TCP` `UDP`.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"TCP", "UDP", "HTTP", "HTTPS"}, false),
+		},
+		"lb_port": {
+			Type:         schema.TypeInt,
+			Description:  "The port of the listener for the multi load balancer.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"instance_port": {
+			Type:         schema.TypeInt,
+			Description:  "The port on the instance to route to.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The instance name to place in the multi load balancer pool.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"wait_for_healthy": {
+			Type:        schema.TypeBool,
+			Description: "If true, the creation waits until the health check of the instance becomes InService. Changing this does not affect the existing attachment.",
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
 	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
 )
 
 func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -24,8 +25,8 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		return diag.FromErr(fmt.Errorf("failed creating elb listener: %s", err))
 	}
 
-	mutexKV.Lock(nifcloud.ToString(input.ElasticLoadBalancerId))
-	defer mutexKV.Unlock(nifcloud.ToString(input.ElasticLoadBalancerId))
+	mutexkv.LockELB(nifcloud.ToString(input.ElasticLoadBalancerId))
+	defer mutexkv.UnlockELB(nifcloud.ToString(input.ElasticLoadBalancerId))
 
 	err = computing.NewElasticLoadBalancerAvailableWaiter(svc).Wait(ctx, expandNiftyDescribeElasticLoadBalancersInputWithID(d), time.Until(deadline))
 	if err != nil {
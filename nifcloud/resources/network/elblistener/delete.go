@@ -10,6 +10,7 @@ import (
 	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
 	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
 )
 
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -17,8 +18,8 @@ func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	svc := meta.(*client.Client).Computing
 	deadline, _ := ctx.Deadline()
 
-	mutexKV.Lock(nifcloud.ToString(input.ElasticLoadBalancerId))
-	defer mutexKV.Unlock(nifcloud.ToString(input.ElasticLoadBalancerId))
+	mutexkv.LockELB(nifcloud.ToString(input.ElasticLoadBalancerId))
+	defer mutexkv.UnlockELB(nifcloud.ToString(input.ElasticLoadBalancerId))
 
 	_, err := svc.NiftyDeleteElasticLoadBalancer(ctx, input)
 
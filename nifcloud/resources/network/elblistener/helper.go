@@ -6,11 +6,8 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
 )
 
-var mutexKV = mutexkv.NewMutexKV()
-
 func validateELBImportString(importStr string) ([]string, error) {
 	// example: example_TCP_8000_8000
 
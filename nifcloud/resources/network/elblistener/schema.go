@@ -132,7 +132,7 @@ func newSchema() map[string]*schema.Schema {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description: "A list of instance names to place in the multi load balancer pool.",
+			Description: "A list of instance names to place in the multi load balancer pool. Add this to `ignore_changes` when `nifcloud_elb_instance_attachment` is used for the same listener.",
 			Optional:    true,
 		},
 		"session_stickiness_policy_enable": {
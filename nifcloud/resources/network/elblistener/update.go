@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
 )
 
 func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -25,8 +26,8 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 			return diag.FromErr(fmt.Errorf("failed wait until elb available: %s", err))
 		}
 	} else {
-		mutexKV.Lock(getELBID(d))
-		defer mutexKV.Unlock(getELBID(d))
+		mutexkv.LockELB(getELBID(d))
+		defer mutexkv.UnlockELB(getELBID(d))
 	}
 
 	// lintignore:R019
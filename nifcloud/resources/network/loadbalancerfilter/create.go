@@ -0,0 +1,28 @@
+package loadbalancerfilter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandSetFilterForLoadBalancerInput(d, true)
+	svc := meta.(*client.Client).Computing
+
+	lbName := d.Get("load_balancer_name").(string)
+	mutexkv.LockLoadBalancer(lbName)
+	defer mutexkv.UnlockLoadBalancer(lbName)
+
+	if _, err := svc.SetFilterForLoadBalancer(ctx, input); err != nil {
+		return diag.FromErr(fmt.Errorf("failed adding load balancer filter: %s", err))
+	}
+
+	d.SetId(buildID(d))
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,38 @@
+package loadbalancerfilter
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandSetFilterForLoadBalancerInput(d *schema.ResourceData, addOnFilter bool) *computing.SetFilterForLoadBalancerInput {
+	return &computing.SetFilterForLoadBalancerInput{
+		LoadBalancerName: nifcloud.String(d.Get("load_balancer_name").(string)),
+		LoadBalancerPort: nifcloud.Int32(int32(d.Get("load_balancer_port").(int))),
+		InstancePort:     nifcloud.Int32(int32(d.Get("instance_port").(int))),
+		IPAddresses: &types.ListOfRequestIPAddresses{
+			Member: []types.RequestIPAddresses{
+				{
+					IPAddress:   nifcloud.String(d.Get("ip_address").(string)),
+					AddOnFilter: nifcloud.Bool(addOnFilter),
+				},
+			},
+		},
+	}
+}
+
+func expandDescribeLoadBalancersInput(d *schema.ResourceData) *computing.DescribeLoadBalancersInput {
+	return &computing.DescribeLoadBalancersInput{
+		LoadBalancerNames: &types.ListOfRequestLoadBalancerNames{
+			Member: []types.RequestLoadBalancerNames{
+				{
+					InstancePort:     nifcloud.Int32(int32(d.Get("instance_port").(int))),
+					LoadBalancerName: nifcloud.String(d.Get("load_balancer_name").(string)),
+					LoadBalancerPort: nifcloud.Int32(int32(d.Get("load_balancer_port").(int))),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package loadbalancerfilter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandSetFilterForLoadBalancerInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"load_balancer_name": "test_load_balancer_name",
+		"load_balancer_port": 80,
+		"instance_port":      8080,
+		"ip_address":         "192.0.2.1",
+	})
+
+	tests := []struct {
+		name        string
+		args        *schema.ResourceData
+		addOnFilter bool
+		want        *computing.SetFilterForLoadBalancerInput
+	}{
+		{
+			name:        "expands the resource data for adding",
+			args:        rd,
+			addOnFilter: true,
+			want: &computing.SetFilterForLoadBalancerInput{
+				LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+				LoadBalancerPort: nifcloud.Int32(80),
+				InstancePort:     nifcloud.Int32(8080),
+				IPAddresses: &types.ListOfRequestIPAddresses{
+					Member: []types.RequestIPAddresses{
+						{IPAddress: nifcloud.String("192.0.2.1"), AddOnFilter: nifcloud.Bool(true)},
+					},
+				},
+			},
+		},
+		{
+			name:        "expands the resource data for removing",
+			args:        rd,
+			addOnFilter: false,
+			want: &computing.SetFilterForLoadBalancerInput{
+				LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+				LoadBalancerPort: nifcloud.Int32(80),
+				InstancePort:     nifcloud.Int32(8080),
+				IPAddresses: &types.ListOfRequestIPAddresses{
+					Member: []types.RequestIPAddresses{
+						{IPAddress: nifcloud.String("192.0.2.1"), AddOnFilter: nifcloud.Bool(false)},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandSetFilterForLoadBalancerInput(tt.args, tt.addOnFilter)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeLoadBalancersInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"load_balancer_name": "test_load_balancer_name",
+		"load_balancer_port": 80,
+		"instance_port":      8080,
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeLoadBalancersInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeLoadBalancersInput{
+				LoadBalancerNames: &types.ListOfRequestLoadBalancerNames{
+					Member: []types.RequestLoadBalancerNames{
+						{
+							InstancePort:     nifcloud.Int32(8080),
+							LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+							LoadBalancerPort: nifcloud.Int32(80),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeLoadBalancersInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
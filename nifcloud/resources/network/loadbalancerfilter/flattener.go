@@ -0,0 +1,33 @@
+package loadbalancerfilter
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeLoadBalancersOutput) error {
+	if res == nil || len(res.DescribeLoadBalancersResult.LoadBalancerDescriptions) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	loadBalancer := res.DescribeLoadBalancersResult.LoadBalancerDescriptions[0]
+	if nifcloud.ToString(loadBalancer.LoadBalancerName) != d.Get("load_balancer_name") {
+		return fmt.Errorf("unable to find load balancer within: %#v", loadBalancer.LoadBalancerName)
+	}
+
+	if loadBalancer.Filter != nil {
+		for _, filter := range loadBalancer.Filter.IPAddresses {
+			if nifcloud.ToString(filter.IPAddress) == d.Get("ip_address").(string) {
+				return d.Set("ip_address", filter.IPAddress)
+			}
+		}
+	}
+
+	// The filter has gone when the IP address has been removed externally.
+	d.SetId("")
+	return nil
+}
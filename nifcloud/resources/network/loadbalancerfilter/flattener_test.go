@@ -0,0 +1,131 @@
+package loadbalancerfilter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"load_balancer_name": "test_load_balancer_name",
+		"load_balancer_port": 80,
+		"instance_port":      8080,
+		"ip_address":         "192.0.2.1",
+	})
+	rd.SetId("test_load_balancer_name_80_8080_192.0.2.1")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantRemovedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"load_balancer_name": "test_load_balancer_name",
+		"load_balancer_port": 80,
+		"instance_port":      8080,
+		"ip_address":         "192.0.2.1",
+	})
+	wantRemovedRd.SetId("test_load_balancer_name_80_8080_192.0.2.1")
+
+	wantRemovedStateRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"load_balancer_name": "test_load_balancer_name",
+		"load_balancer_port": 80,
+		"instance_port":      8080,
+		"ip_address":         "192.0.2.1",
+	})
+
+	type args struct {
+		res *computing.DescribeLoadBalancersOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.DescribeLoadBalancersOutput{
+					DescribeLoadBalancersResult: &types.DescribeLoadBalancersResult{
+						LoadBalancerDescriptions: []types.LoadBalancerDescriptions{
+							{
+								LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+								Filter: &types.Filter{
+									FilterType: nifcloud.String("1"),
+									IPAddresses: []types.IPAddresses{
+										{IPAddress: nifcloud.String("192.0.2.2")},
+										{IPAddress: nifcloud.String("192.0.2.1")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.DescribeLoadBalancersOutput{
+					DescribeLoadBalancersResult: &types.DescribeLoadBalancersResult{
+						LoadBalancerDescriptions: []types.LoadBalancerDescriptions{},
+					},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the filter has been removed externally",
+			args: args{
+				d: wantRemovedRd,
+				res: &computing.DescribeLoadBalancersOutput{
+					DescribeLoadBalancersResult: &types.DescribeLoadBalancersResult{
+						LoadBalancerDescriptions: []types.LoadBalancerDescriptions{
+							{
+								LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+								Filter: &types.Filter{
+									FilterType: nifcloud.String("1"),
+									IPAddresses: []types.IPAddresses{
+										{IPAddress: nifcloud.String("192.0.2.2")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: wantRemovedStateRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package loadbalancerfilter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func validateImportString(importStr string) ([]string, error) {
+	// example: example_8000_8000_192.0.2.1
+
+	importParts := strings.Split(importStr, "_")
+	errStr := "unexpected format of import string (%q), expected LBNAME_LBPORT_INSTANCEPORT_IPADDRESS: %s"
+	if len(importParts) != 4 {
+		return nil, fmt.Errorf(errStr, importStr, "invalid parts")
+	}
+
+	lbName := importParts[0]
+	lbPort := importParts[1]
+	instancePort := importParts[2]
+	ipAddress := importParts[3]
+
+	if lbName == "" {
+		return nil, fmt.Errorf(errStr, importStr, "load balancer name must be required")
+	}
+
+	if _, err := strconv.Atoi(lbPort); err != nil {
+		return nil, fmt.Errorf(errStr, importStr, "invalid lb port")
+	}
+	if _, err := strconv.Atoi(instancePort); err != nil {
+		return nil, fmt.Errorf(errStr, importStr, "invalid instance port")
+	}
+
+	if ipAddress == "" {
+		return nil, fmt.Errorf(errStr, importStr, "ip address must be required")
+	}
+	return importParts, nil
+}
+
+func populateFromImport(d *schema.ResourceData, importParts []string) error {
+	lbName := importParts[0]
+	lbPort := importParts[1]
+	instancePort := importParts[2]
+	ipAddress := importParts[3]
+
+	if err := d.Set("load_balancer_name", lbName); err != nil {
+		return err
+	}
+
+	p, err := strconv.Atoi(lbPort)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("load_balancer_port", p); err != nil {
+		return err
+	}
+
+	p, err = strconv.Atoi(instancePort)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_port", p); err != nil {
+		return err
+	}
+
+	if err := d.Set("ip_address", ipAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func buildID(d *schema.ResourceData) string {
+	return strings.Join([]string{
+		d.Get("load_balancer_name").(string),
+		strconv.Itoa(d.Get("load_balancer_port").(int)),
+		strconv.Itoa(d.Get("instance_port").(int)),
+		d.Get("ip_address").(string),
+	}, "_")
+}
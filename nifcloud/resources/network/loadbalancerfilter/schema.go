@@ -0,0 +1,79 @@
+package loadbalancerfilter
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Provide a load_balancer_filter resource"
+
+// New returns the nifcloud_load_balancer_filter resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importParts, err := validateImportString(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				if err := populateFromImport(d, importParts); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"load_balancer_name": {
+			Type:        schema.TypeString,
+			Description: "The name for the load_balancer.",
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 15),
+				validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z]+$`), "Enter the load_balancer_name within 1-15 characters [0-9a-zA-Z]."),
+			),
+		},
+		"load_balancer_port": {
+			Type:         schema.TypeInt,
+			Description:  "The port to listen on for the load balancer.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"instance_port": {
+			Type:         schema.TypeInt,
+			Description:  "The port on the instance to route to.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"ip_address": {
+			Type:        schema.TypeString,
+			Description: "The IP address to allow or deny. The filter type is set by `filter_type` of the load balancer.",
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.Any(
+				validation.IsIPv4Address,
+				validation.IsCIDR,
+			),
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package loadbalancerinstanceattachment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if err := register(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed registering instance with load balancer: %s", err))
+	}
+
+	d.SetId(buildID(d))
+
+	if d.Get("wait_for_healthy").(bool) {
+		if err := waitUntilInstanceInService(ctx, d, svc); err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for instance to become healthy: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
+
+func register(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	lbName := d.Get("load_balancer_name").(string)
+	mutexkv.LockLoadBalancer(lbName)
+	defer mutexkv.UnlockLoadBalancer(lbName)
+
+	_, err := svc.RegisterInstancesWithLoadBalancer(ctx, expandRegisterInstancesWithLoadBalancerInput(d))
+	return err
+}
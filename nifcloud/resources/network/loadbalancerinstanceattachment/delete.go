@@ -0,0 +1,34 @@
+package loadbalancerinstanceattachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandDeregisterInstancesFromLoadBalancerInput(d)
+	svc := meta.(*client.Client).Computing
+
+	lbName := d.Get("load_balancer_name").(string)
+	mutexkv.LockLoadBalancer(lbName)
+	defer mutexkv.UnlockLoadBalancer(lbName)
+
+	if _, err := svc.DeregisterInstancesFromLoadBalancer(ctx, input); err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.LoadBalancerName" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed deregistering instance from load balancer: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,61 @@
+package loadbalancerinstanceattachment
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandRegisterInstancesWithLoadBalancerInput(d *schema.ResourceData) *computing.RegisterInstancesWithLoadBalancerInput {
+	return &computing.RegisterInstancesWithLoadBalancerInput{
+		LoadBalancerName: nifcloud.String(d.Get("load_balancer_name").(string)),
+		LoadBalancerPort: nifcloud.Int32(int32(d.Get("load_balancer_port").(int))),
+		InstancePort:     nifcloud.Int32(int32(d.Get("instance_port").(int))),
+		Instances: &types.ListOfRequestInstances{
+			Member: []types.RequestInstances{
+				{InstanceId: nifcloud.String(d.Get("instance_id").(string))},
+			},
+		},
+	}
+}
+
+func expandDeregisterInstancesFromLoadBalancerInput(d *schema.ResourceData) *computing.DeregisterInstancesFromLoadBalancerInput {
+	return &computing.DeregisterInstancesFromLoadBalancerInput{
+		LoadBalancerName: nifcloud.String(d.Get("load_balancer_name").(string)),
+		LoadBalancerPort: nifcloud.Int32(int32(d.Get("load_balancer_port").(int))),
+		InstancePort:     nifcloud.Int32(int32(d.Get("instance_port").(int))),
+		Instances: &types.ListOfRequestInstances{
+			Member: []types.RequestInstances{
+				{InstanceId: nifcloud.String(d.Get("instance_id").(string))},
+			},
+		},
+	}
+}
+
+func expandDescribeLoadBalancersInput(d *schema.ResourceData) *computing.DescribeLoadBalancersInput {
+	return &computing.DescribeLoadBalancersInput{
+		LoadBalancerNames: &types.ListOfRequestLoadBalancerNames{
+			Member: []types.RequestLoadBalancerNames{
+				{
+					InstancePort:     nifcloud.Int32(int32(d.Get("instance_port").(int))),
+					LoadBalancerName: nifcloud.String(d.Get("load_balancer_name").(string)),
+					LoadBalancerPort: nifcloud.Int32(int32(d.Get("load_balancer_port").(int))),
+				},
+			},
+		},
+	}
+}
+
+func expandDescribeInstanceHealthInput(d *schema.ResourceData) *computing.DescribeInstanceHealthInput {
+	return &computing.DescribeInstanceHealthInput{
+		LoadBalancerName: nifcloud.String(d.Get("load_balancer_name").(string)),
+		LoadBalancerPort: nifcloud.Int32(int32(d.Get("load_balancer_port").(int))),
+		InstancePort:     nifcloud.Int32(int32(d.Get("instance_port").(int))),
+		Instances: &types.ListOfRequestInstancesOfDescribeInstanceHealth{
+			Member: []types.RequestInstancesOfDescribeInstanceHealth{
+				{InstanceId: nifcloud.String(d.Get("instance_id").(string))},
+			},
+		},
+	}
+}
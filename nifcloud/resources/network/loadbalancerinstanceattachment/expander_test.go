@@ -0,0 +1,155 @@
+package loadbalancerinstanceattachment
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandRegisterInstancesWithLoadBalancerInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"load_balancer_name": "test_load_balancer_name",
+		"load_balancer_port": 80,
+		"instance_port":      8080,
+		"instance_id":        "test_instance_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.RegisterInstancesWithLoadBalancerInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.RegisterInstancesWithLoadBalancerInput{
+				LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+				LoadBalancerPort: nifcloud.Int32(80),
+				InstancePort:     nifcloud.Int32(8080),
+				Instances: &types.ListOfRequestInstances{
+					Member: []types.RequestInstances{
+						{InstanceId: nifcloud.String("test_instance_id")},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandRegisterInstancesWithLoadBalancerInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDeregisterInstancesFromLoadBalancerInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"load_balancer_name": "test_load_balancer_name",
+		"load_balancer_port": 80,
+		"instance_port":      8080,
+		"instance_id":        "test_instance_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DeregisterInstancesFromLoadBalancerInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DeregisterInstancesFromLoadBalancerInput{
+				LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+				LoadBalancerPort: nifcloud.Int32(80),
+				InstancePort:     nifcloud.Int32(8080),
+				Instances: &types.ListOfRequestInstances{
+					Member: []types.RequestInstances{
+						{InstanceId: nifcloud.String("test_instance_id")},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDeregisterInstancesFromLoadBalancerInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeLoadBalancersInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"load_balancer_name": "test_load_balancer_name",
+		"load_balancer_port": 80,
+		"instance_port":      8080,
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeLoadBalancersInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeLoadBalancersInput{
+				LoadBalancerNames: &types.ListOfRequestLoadBalancerNames{
+					Member: []types.RequestLoadBalancerNames{
+						{
+							InstancePort:     nifcloud.Int32(8080),
+							LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+							LoadBalancerPort: nifcloud.Int32(80),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeLoadBalancersInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeInstanceHealthInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"load_balancer_name": "test_load_balancer_name",
+		"load_balancer_port": 80,
+		"instance_port":      8080,
+		"instance_id":        "test_instance_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeInstanceHealthInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeInstanceHealthInput{
+				LoadBalancerName: nifcloud.String("test_load_balancer_name"),
+				LoadBalancerPort: nifcloud.Int32(80),
+				InstancePort:     nifcloud.Int32(8080),
+				Instances: &types.ListOfRequestInstancesOfDescribeInstanceHealth{
+					Member: []types.RequestInstancesOfDescribeInstanceHealth{
+						{InstanceId: nifcloud.String("test_instance_id")},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeInstanceHealthInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package loadbalancerinstanceattachment
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeLoadBalancersOutput) error {
+	if res == nil || len(res.DescribeLoadBalancersResult.LoadBalancerDescriptions) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	loadBalancer := res.DescribeLoadBalancersResult.LoadBalancerDescriptions[0]
+	if nifcloud.ToString(loadBalancer.LoadBalancerName) != d.Get("load_balancer_name") {
+		return fmt.Errorf("unable to find load balancer within: %#v", loadBalancer.LoadBalancerName)
+	}
+
+	for _, instance := range loadBalancer.Instances {
+		if nifcloud.ToString(instance.InstanceId) == d.Get("instance_id").(string) {
+			return d.Set("instance_id", instance.InstanceId)
+		}
+	}
+
+	// The attachment has gone when the instance has been deregistered externally.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,116 @@
+package loadbalancerinstanceattachment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+const instanceStateInService = "InService"
+
+func validateImportString(importStr string) ([]string, error) {
+	// example: example_8000_8000_web001
+
+	importParts := strings.Split(importStr, "_")
+	errStr := "unexpected format of import string (%q), expected LBNAME_LBPORT_INSTANCEPORT_INSTANCEID: %s"
+	if len(importParts) != 4 {
+		return nil, fmt.Errorf(errStr, importStr, "invalid parts")
+	}
+
+	lbName := importParts[0]
+	lbPort := importParts[1]
+	instancePort := importParts[2]
+	instanceID := importParts[3]
+
+	if lbName == "" {
+		return nil, fmt.Errorf(errStr, importStr, "load balancer name must be required")
+	}
+
+	if _, err := strconv.Atoi(lbPort); err != nil {
+		return nil, fmt.Errorf(errStr, importStr, "invalid lb port")
+	}
+	if _, err := strconv.Atoi(instancePort); err != nil {
+		return nil, fmt.Errorf(errStr, importStr, "invalid instance port")
+	}
+
+	if instanceID == "" {
+		return nil, fmt.Errorf(errStr, importStr, "instance id must be required")
+	}
+	return importParts, nil
+}
+
+func populateFromImport(d *schema.ResourceData, importParts []string) error {
+	lbName := importParts[0]
+	lbPort := importParts[1]
+	instancePort := importParts[2]
+	instanceID := importParts[3]
+
+	if err := d.Set("load_balancer_name", lbName); err != nil {
+		return err
+	}
+
+	p, err := strconv.Atoi(lbPort)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("load_balancer_port", p); err != nil {
+		return err
+	}
+
+	p, err = strconv.Atoi(instancePort)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_port", p); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_id", instanceID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func buildID(d *schema.ResourceData) string {
+	return strings.Join([]string{
+		d.Get("load_balancer_name").(string),
+		strconv.Itoa(d.Get("load_balancer_port").(int)),
+		strconv.Itoa(d.Get("instance_port").(int)),
+		d.Get("instance_id").(string),
+	}, "_")
+}
+
+func waitUntilInstanceInService(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	deadline, _ := ctx.Deadline()
+
+	return resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
+		res, err := svc.DescribeInstanceHealth(ctx, expandDescribeInstanceHealthInput(d))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, s := range res.DescribeInstanceHealthResult.InstanceStates {
+			if nifcloud.ToString(s.InstanceId) != d.Get("instance_id").(string) {
+				continue
+			}
+
+			state := nifcloud.ToString(s.State)
+			if state == instanceStateInService {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("expected instance %s to be InService but was in state %s", d.Get("instance_id"), state))
+		}
+
+		return resource.RetryableError(fmt.Errorf("expected health of instance %s to be found", d.Get("instance_id")))
+	})
+}
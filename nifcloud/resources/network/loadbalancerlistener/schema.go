@@ -57,7 +57,7 @@ func newSchema() map[string]*schema.Schema {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description: "A list of IP address filter for load balancer",
+			Description: "A list of IP address filter for load balancer. Add this to `ignore_changes` when `nifcloud_load_balancer_filter` is used for the same listener.",
 			Optional:    true,
 		},
 		"filter_type": {
@@ -98,7 +98,7 @@ func newSchema() map[string]*schema.Schema {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description: "A list of instance names to place in the load balancer pool.",
+			Description: "A list of instance names to place in the load balancer pool. Add this to `ignore_changes` when `nifcloud_load_balancer_instance_attachment` is used for the same listener.",
 			Optional:    true,
 		},
 		"instance_port": {
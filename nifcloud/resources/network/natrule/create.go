@@ -0,0 +1,26 @@
+package natrule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	natTableID := d.Get("nat_table_id").(string)
+	mutexKV.Lock(natTableID)
+	defer mutexKV.Unlock(natTableID)
+
+	if _, err := svc.NiftyCreateNatRule(ctx, expandNiftyCreateNatRuleInput(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed creating nat rule: %s", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s_%s", natTableID, d.Get("nat_type").(string), d.Get("rule_number").(string)))
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,32 @@
+package natrule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	natTableID := d.Get("nat_table_id").(string)
+	mutexKV.Lock(natTableID)
+	defer mutexKV.Unlock(natTableID)
+
+	if _, err := svc.NiftyDeleteNatRule(ctx, expandNiftyDeleteNatRuleInput(d)); err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.NatTableId" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed deleting nat rule: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,104 @@
+package natrule
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandNiftyCreateNatRuleInput(d *schema.ResourceData) *computing.NiftyCreateNatRuleInput {
+	input := &computing.NiftyCreateNatRuleInput{
+		NatTableId:  nifcloud.String(d.Get("nat_table_id").(string)),
+		NatType:     types.NatTypeOfNiftyCreateNatRuleRequest(d.Get("nat_type").(string)),
+		RuleNumber:  nifcloud.String(d.Get("rule_number").(string)),
+		Description: nifcloud.String(d.Get("description").(string)),
+		Protocol:    types.ProtocolOfNiftyCreateNatRuleRequest(d.Get("protocol").(string)),
+	}
+
+	if d.Get("nat_type").(string) == "snat" {
+		input.Source = expandSource(d)
+		input.Translation = &types.RequestTranslation{
+			Port: nifcloud.Int32(int32(d.Get("translation_port").(int))),
+		}
+		input.OutboundInterface = expandOutboundInterface(d)
+	} else {
+		input.Destination = expandDestination(d)
+		input.Translation = expandTranslation(d)
+		input.InboundInterface = expandInboundInterface(d)
+	}
+
+	return input
+}
+
+func expandNiftyReplaceNatRuleInput(d *schema.ResourceData) *computing.NiftyReplaceNatRuleInput {
+	input := &computing.NiftyReplaceNatRuleInput{
+		NatTableId:  nifcloud.String(d.Get("nat_table_id").(string)),
+		NatType:     types.NatTypeOfNiftyReplaceNatRuleRequest(d.Get("nat_type").(string)),
+		RuleNumber:  nifcloud.String(d.Get("rule_number").(string)),
+		Description: nifcloud.String(d.Get("description").(string)),
+		Protocol:    types.ProtocolOfNiftyReplaceNatRuleRequest(d.Get("protocol").(string)),
+	}
+
+	if d.Get("nat_type").(string) == "snat" {
+		input.Source = expandSource(d)
+		input.Translation = &types.RequestTranslation{
+			Port: nifcloud.Int32(int32(d.Get("translation_port").(int))),
+		}
+		input.OutboundInterface = expandOutboundInterface(d)
+	} else {
+		input.Destination = expandDestination(d)
+		input.Translation = expandTranslation(d)
+		input.InboundInterface = expandInboundInterface(d)
+	}
+
+	return input
+}
+
+func expandNiftyDeleteNatRuleInput(d *schema.ResourceData) *computing.NiftyDeleteNatRuleInput {
+	return &computing.NiftyDeleteNatRuleInput{
+		NatTableId: nifcloud.String(d.Get("nat_table_id").(string)),
+		NatType:    types.NatTypeOfNiftyDeleteNatRuleRequest(d.Get("nat_type").(string)),
+		RuleNumber: nifcloud.String(d.Get("rule_number").(string)),
+	}
+}
+
+func expandNiftyDescribeNatTablesInput(d *schema.ResourceData) *computing.NiftyDescribeNatTablesInput {
+	return &computing.NiftyDescribeNatTablesInput{
+		NatTableId: []string{d.Get("nat_table_id").(string)},
+	}
+}
+
+func expandSource(d *schema.ResourceData) *types.RequestSource {
+	return &types.RequestSource{
+		Address: nifcloud.String(d.Get("source_address").(string)),
+		Port:    nifcloud.Int32(int32(d.Get("source_port").(int))),
+	}
+}
+
+func expandDestination(d *schema.ResourceData) *types.RequestDestination {
+	return &types.RequestDestination{
+		Port: nifcloud.Int32(int32(d.Get("destination_port").(int))),
+	}
+}
+
+func expandTranslation(d *schema.ResourceData) *types.RequestTranslation {
+	return &types.RequestTranslation{
+		Address: nifcloud.String(d.Get("translation_address").(string)),
+		Port:    nifcloud.Int32(int32(d.Get("translation_port").(int))),
+	}
+}
+
+func expandOutboundInterface(d *schema.ResourceData) *types.RequestOutboundInterface {
+	return &types.RequestOutboundInterface{
+		NetworkId:   nifcloud.String(d.Get("outbound_interface_network_id").(string)),
+		NetworkName: nifcloud.String(d.Get("outbound_interface_network_name").(string)),
+	}
+}
+
+func expandInboundInterface(d *schema.ResourceData) *types.RequestInboundInterface {
+	return &types.RequestInboundInterface{
+		NetworkId:   nifcloud.String(d.Get("inbound_interface_network_id").(string)),
+		NetworkName: nifcloud.String(d.Get("inbound_interface_network_name").(string)),
+	}
+}
@@ -0,0 +1,230 @@
+package natrule
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandNiftyCreateNatRuleInput(t *testing.T) {
+	snatRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id":                  "test_nat_table_id",
+		"nat_type":                      "snat",
+		"rule_number":                   "1",
+		"description":                   "test_description",
+		"protocol":                      "TCP",
+		"source_address":                "192.168.1.1",
+		"source_port":                   80,
+		"translation_port":              81,
+		"outbound_interface_network_id": "net-COMMON_GLOBAL",
+	})
+
+	dnatRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id":                   "test_nat_table_id",
+		"nat_type":                       "dnat",
+		"rule_number":                    "2",
+		"description":                    "test_description",
+		"protocol":                       "TCP",
+		"destination_port":               8080,
+		"translation_address":            "192.168.1.2",
+		"translation_port":               80,
+		"inbound_interface_network_name": "test_network_name",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyCreateNatRuleInput
+	}{
+		{
+			name: "expands the resource data for snat",
+			args: snatRd,
+			want: &computing.NiftyCreateNatRuleInput{
+				NatTableId:  nifcloud.String("test_nat_table_id"),
+				NatType:     types.NatTypeOfNiftyCreateNatRuleRequestSnat,
+				RuleNumber:  nifcloud.String("1"),
+				Description: nifcloud.String("test_description"),
+				Protocol:    types.ProtocolOfNiftyCreateNatRuleRequestTcp,
+				Source: &types.RequestSource{
+					Address: nifcloud.String("192.168.1.1"),
+					Port:    nifcloud.Int32(80),
+				},
+				Translation: &types.RequestTranslation{
+					Port: nifcloud.Int32(81),
+				},
+				OutboundInterface: &types.RequestOutboundInterface{
+					NetworkId:   nifcloud.String("net-COMMON_GLOBAL"),
+					NetworkName: nifcloud.String(""),
+				},
+			},
+		},
+		{
+			name: "expands the resource data for dnat",
+			args: dnatRd,
+			want: &computing.NiftyCreateNatRuleInput{
+				NatTableId:  nifcloud.String("test_nat_table_id"),
+				NatType:     types.NatTypeOfNiftyCreateNatRuleRequestDnat,
+				RuleNumber:  nifcloud.String("2"),
+				Description: nifcloud.String("test_description"),
+				Protocol:    types.ProtocolOfNiftyCreateNatRuleRequestTcp,
+				Destination: &types.RequestDestination{
+					Port: nifcloud.Int32(8080),
+				},
+				Translation: &types.RequestTranslation{
+					Address: nifcloud.String("192.168.1.2"),
+					Port:    nifcloud.Int32(80),
+				},
+				InboundInterface: &types.RequestInboundInterface{
+					NetworkId:   nifcloud.String(""),
+					NetworkName: nifcloud.String("test_network_name"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyCreateNatRuleInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyReplaceNatRuleInput(t *testing.T) {
+	snatRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id":                    "test_nat_table_id",
+		"nat_type":                        "snat",
+		"rule_number":                     "1",
+		"description":                     "test_description",
+		"protocol":                        "ALL",
+		"source_address":                  "192.168.1.10",
+		"outbound_interface_network_name": "test_network_name",
+	})
+
+	dnatRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id":                 "test_nat_table_id",
+		"nat_type":                     "dnat",
+		"rule_number":                  "2",
+		"protocol":                     "UDP",
+		"destination_port":             53,
+		"translation_address":          "192.168.1.2",
+		"translation_port":             53,
+		"inbound_interface_network_id": "net-COMMON_GLOBAL",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyReplaceNatRuleInput
+	}{
+		{
+			name: "expands the resource data for snat",
+			args: snatRd,
+			want: &computing.NiftyReplaceNatRuleInput{
+				NatTableId:  nifcloud.String("test_nat_table_id"),
+				NatType:     types.NatTypeOfNiftyReplaceNatRuleRequestSnat,
+				RuleNumber:  nifcloud.String("1"),
+				Description: nifcloud.String("test_description"),
+				Protocol:    types.ProtocolOfNiftyReplaceNatRuleRequestAll,
+				Source: &types.RequestSource{
+					Address: nifcloud.String("192.168.1.10"),
+					Port:    nifcloud.Int32(0),
+				},
+				Translation: &types.RequestTranslation{
+					Port: nifcloud.Int32(0),
+				},
+				OutboundInterface: &types.RequestOutboundInterface{
+					NetworkId:   nifcloud.String(""),
+					NetworkName: nifcloud.String("test_network_name"),
+				},
+			},
+		},
+		{
+			name: "expands the resource data for dnat",
+			args: dnatRd,
+			want: &computing.NiftyReplaceNatRuleInput{
+				NatTableId:  nifcloud.String("test_nat_table_id"),
+				NatType:     types.NatTypeOfNiftyReplaceNatRuleRequestDnat,
+				RuleNumber:  nifcloud.String("2"),
+				Description: nifcloud.String(""),
+				Protocol:    types.ProtocolOfNiftyReplaceNatRuleRequestUdp,
+				Destination: &types.RequestDestination{
+					Port: nifcloud.Int32(53),
+				},
+				Translation: &types.RequestTranslation{
+					Address: nifcloud.String("192.168.1.2"),
+					Port:    nifcloud.Int32(53),
+				},
+				InboundInterface: &types.RequestInboundInterface{
+					NetworkId:   nifcloud.String("net-COMMON_GLOBAL"),
+					NetworkName: nifcloud.String(""),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyReplaceNatRuleInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDeleteNatRuleInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id": "test_nat_table_id",
+		"nat_type":     "dnat",
+		"rule_number":  "2",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDeleteNatRuleInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDeleteNatRuleInput{
+				NatTableId: nifcloud.String("test_nat_table_id"),
+				NatType:    types.NatTypeOfNiftyDeleteNatRuleRequestDnat,
+				RuleNumber: nifcloud.String("2"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDeleteNatRuleInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeNatTablesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id": "test_nat_table_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeNatTablesInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeNatTablesInput{
+				NatTableId: []string{"test_nat_table_id"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeNatTablesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
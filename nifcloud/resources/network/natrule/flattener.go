@@ -0,0 +1,110 @@
+package natrule
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, res *computing.NiftyDescribeNatTablesOutput) error {
+	if res == nil || len(res.NatTableSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	natTable := res.NatTableSet[0]
+
+	if nifcloud.ToString(natTable.NatTableId) != d.Get("nat_table_id").(string) {
+		return fmt.Errorf("unable to find nat table within: %#v", res.NatTableSet)
+	}
+
+	var rule *types.NatRuleSet
+	for _, r := range natTable.NatRuleSet {
+		if nifcloud.ToString(r.NatType) == d.Get("nat_type").(string) &&
+			nifcloud.ToString(r.RuleNumber) == d.Get("rule_number").(string) {
+			r := r
+			rule = &r
+			break
+		}
+	}
+
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("description", rule.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("protocol", rule.Protocol); err != nil {
+		return err
+	}
+
+	hasPort := nifcloud.ToString(rule.Protocol) != "ALL" && nifcloud.ToString(rule.Protocol) != "ICMP"
+
+	if nifcloud.ToString(rule.NatType) == "snat" {
+		if err := d.Set("source_address", rule.Source.Address); err != nil {
+			return err
+		}
+
+		if hasPort {
+			if err := d.Set("source_port", nifcloud.ToInt32(rule.Source.Port)); err != nil {
+				return err
+			}
+
+			if err := d.Set("translation_port", nifcloud.ToInt32(rule.Translation.Port)); err != nil {
+				return err
+			}
+		}
+
+		if err := flattenInterface(d, "outbound_interface", rule.OutboundInterface.NetworkId, rule.OutboundInterface.NetworkName); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := d.Set("translation_address", rule.Translation.Address); err != nil {
+		return err
+	}
+
+	if hasPort {
+		if err := d.Set("destination_port", nifcloud.ToInt32(rule.Destination.Port)); err != nil {
+			return err
+		}
+
+		if err := d.Set("translation_port", nifcloud.ToInt32(rule.Translation.Port)); err != nil {
+			return err
+		}
+	}
+
+	if err := flattenInterface(d, "inbound_interface", rule.InboundInterface.NetworkId, rule.InboundInterface.NetworkName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// flattenInterface keeps the network id or name that is configured, or both of them after import.
+func flattenInterface(d *schema.ResourceData, prefix string, networkID, networkName *string) error {
+	configuredID := d.Get(prefix + "_network_id").(string)
+	configuredName := d.Get(prefix + "_network_name").(string)
+
+	if configuredID != "" || configuredName == "" {
+		if err := d.Set(prefix+"_network_id", networkID); err != nil {
+			return err
+		}
+	}
+
+	if configuredName != "" || configuredID == "" {
+		if err := d.Set(prefix+"_network_name", networkName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
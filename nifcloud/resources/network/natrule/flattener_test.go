@@ -0,0 +1,182 @@
+package natrule
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	snatRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id":                  "test_nat_table_id",
+		"nat_type":                      "snat",
+		"rule_number":                   "1",
+		"description":                   "test_description",
+		"protocol":                      "TCP",
+		"source_address":                "192.168.1.1",
+		"source_port":                   80,
+		"translation_port":              81,
+		"outbound_interface_network_id": "net-COMMON_GLOBAL",
+	})
+	snatRd.SetId("test_nat_table_id_snat_1")
+
+	dnatRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id":                   "test_nat_table_id",
+		"nat_type":                       "dnat",
+		"rule_number":                    "1",
+		"description":                    "test_description",
+		"protocol":                       "TCP",
+		"destination_port":               8080,
+		"translation_address":            "192.168.1.2",
+		"translation_port":               80,
+		"inbound_interface_network_name": "test_network_name",
+	})
+	dnatRd.SetId("test_nat_table_id_dnat_1")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantRemovedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id": "test_nat_table_id",
+		"nat_type":     "snat",
+		"rule_number":  "2",
+	})
+	wantRemovedRd.SetId("test_nat_table_id_snat_2")
+
+	wantRemovedStateRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id": "test_nat_table_id",
+		"nat_type":     "snat",
+		"rule_number":  "2",
+	})
+
+	natRuleSet := []types.NatRuleSet{
+		{
+			NatType:     nifcloud.String("snat"),
+			RuleNumber:  nifcloud.String("1"),
+			Description: nifcloud.String("test_description"),
+			Protocol:    nifcloud.String("TCP"),
+			Source: &types.Source{
+				Address: nifcloud.String("192.168.1.1"),
+				Port:    nifcloud.Int32(80),
+			},
+			Translation: &types.Translation{
+				Port: nifcloud.Int32(81),
+			},
+			OutboundInterface: &types.OutboundInterface{
+				NetworkId:   nifcloud.String("net-COMMON_GLOBAL"),
+				NetworkName: nifcloud.String(""),
+			},
+		},
+		{
+			NatType:     nifcloud.String("dnat"),
+			RuleNumber:  nifcloud.String("1"),
+			Description: nifcloud.String("test_description"),
+			Protocol:    nifcloud.String("TCP"),
+			Destination: &types.Destination{
+				Port: nifcloud.Int32(8080),
+			},
+			Translation: &types.Translation{
+				Address: nifcloud.String("192.168.1.2"),
+				Port:    nifcloud.Int32(80),
+			},
+			InboundInterface: &types.InboundInterface{
+				NetworkId:   nifcloud.String("test_network_id"),
+				NetworkName: nifcloud.String("test_network_name"),
+			},
+		},
+	}
+
+	type args struct {
+		res *computing.NiftyDescribeNatTablesOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response for snat",
+			args: args{
+				d: snatRd,
+				res: &computing.NiftyDescribeNatTablesOutput{
+					NatTableSet: []types.NatTableSet{
+						{
+							NatTableId: nifcloud.String("test_nat_table_id"),
+							NatRuleSet: natRuleSet,
+						},
+					},
+				},
+			},
+			want: snatRd,
+		},
+		{
+			name: "flattens the response for dnat",
+			args: args{
+				d: dnatRd,
+				res: &computing.NiftyDescribeNatTablesOutput{
+					NatTableSet: []types.NatTableSet{
+						{
+							NatTableId: nifcloud.String("test_nat_table_id"),
+							NatRuleSet: natRuleSet,
+						},
+					},
+				},
+			},
+			want: dnatRd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeNatTablesOutput{
+					NatTableSet: []types.NatTableSet{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the rule has been removed externally",
+			args: args{
+				d: wantRemovedRd,
+				res: &computing.NiftyDescribeNatTablesOutput{
+					NatTableSet: []types.NatTableSet{
+						{
+							NatTableId: nifcloud.String("test_nat_table_id"),
+							NatRuleSet: natRuleSet,
+						},
+					},
+				},
+			},
+			want: wantRemovedStateRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
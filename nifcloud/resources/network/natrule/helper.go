@@ -0,0 +1,38 @@
+package natrule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+var mutexKV = mutexkv.NewMutexKV()
+
+func populateFromImport(d *schema.ResourceData) error {
+	// example: nat-abcdefgh_dnat_1
+
+	parts := strings.SplitN(d.Id(), "_", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return fmt.Errorf("unexpected format of import string (%q), expected NATTABLEID_NATTYPE_RULENUMBER", d.Id())
+	}
+
+	if parts[1] != "snat" && parts[1] != "dnat" {
+		return fmt.Errorf("unexpected format of import string (%q), NATTYPE must be snat or dnat", d.Id())
+	}
+
+	if err := d.Set("nat_table_id", parts[0]); err != nil {
+		return err
+	}
+
+	if err := d.Set("nat_type", parts[1]); err != nil {
+		return err
+	}
+
+	if err := d.Set("rule_number", parts[2]); err != nil {
+		return err
+	}
+
+	return nil
+}
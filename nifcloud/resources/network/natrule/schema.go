@@ -0,0 +1,141 @@
+package natrule
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/rawconfig"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Provides a nat rule resource."
+
+// New returns the nifcloud_nat_rule resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := populateFromImport(d); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"nat_table_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the nat table.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"nat_type": {
+			Type:         schema.TypeString,
+			Description:  "The type of the nat rule; `snat` or `dnat`.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"snat", "dnat"}, false),
+		},
+		"rule_number": {
+			Type:        schema.TypeString,
+			Description: "The rule number.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"description": {
+			Type:             schema.TypeString,
+			Description:      "The nat table rule description.",
+			Optional:         true,
+			ValidateDiagFunc: validator.StringRuneCountBetween(0, 40),
+		},
+		"protocol": {
+			Type:        schema.TypeString,
+			Description: "The protocol.",
+			Required:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"ALL", "TCP", "UDP", "TCP_UDP", "ICMP",
+			}, false),
+		},
+		"source_address": {
+			Type:             schema.TypeString,
+			Description:      "The source address. Only for `snat`.",
+			Optional:         true,
+			ConflictsWith:    []string{"translation_address"},
+			ValidateDiagFunc: validator.IPAddress,
+		},
+		"source_port": {
+			Type:          schema.TypeInt,
+			Description:   "The source port. Only for `snat`.",
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"destination_port"},
+			ValidateFunc:  validation.IntBetween(0, 65535),
+		},
+		"destination_port": {
+			Type:          schema.TypeInt,
+			Description:   "The destination port. Only for `dnat`.",
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"source_port"},
+			ValidateFunc:  validation.IntBetween(0, 65535),
+		},
+		"translation_address": {
+			Type:             schema.TypeString,
+			Description:      "The translation address. Only for `dnat`.",
+			Optional:         true,
+			ConflictsWith:    []string{"source_address"},
+			ValidateDiagFunc: validator.IPAddress,
+		},
+		"translation_port": {
+			Type:         schema.TypeInt,
+			Description:  "The translation port.",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+		},
+		"outbound_interface_network_id": {
+			Type:             schema.TypeString,
+			Description:      "The outbound interface network id; `net-COMMON_GLOBAL` or `net-COMMON_PRIVATE` or private lan network id. Only for `snat`.",
+			Optional:         true,
+			ConflictsWith:    []string{"outbound_interface_network_name", "inbound_interface_network_id", "inbound_interface_network_name"},
+			DiffSuppressFunc: rawconfig.SuppressRemovalIfConfigured("outbound_interface_network_name"),
+		},
+		"outbound_interface_network_name": {
+			Type:             schema.TypeString,
+			Description:      "The private lan name of target outbound interface network. Only for `snat`.",
+			Optional:         true,
+			ConflictsWith:    []string{"outbound_interface_network_id", "inbound_interface_network_id", "inbound_interface_network_name"},
+			DiffSuppressFunc: rawconfig.SuppressRemovalIfConfigured("outbound_interface_network_id"),
+		},
+		"inbound_interface_network_id": {
+			Type:             schema.TypeString,
+			Description:      "The inbound interface network id; `net-COMMON_GLOBAL` or `net-COMMON_PRIVATE` or private lan network id. Only for `dnat`.",
+			Optional:         true,
+			ConflictsWith:    []string{"inbound_interface_network_name", "outbound_interface_network_id", "outbound_interface_network_name"},
+			DiffSuppressFunc: rawconfig.SuppressRemovalIfConfigured("inbound_interface_network_name"),
+		},
+		"inbound_interface_network_name": {
+			Type:             schema.TypeString,
+			Description:      "The private lan name of target inbound interface network. Only for `dnat`.",
+			Optional:         true,
+			ConflictsWith:    []string{"inbound_interface_network_id", "outbound_interface_network_id", "outbound_interface_network_name"},
+			DiffSuppressFunc: rawconfig.SuppressRemovalIfConfigured("inbound_interface_network_id"),
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package natrule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d.HasChanges(
+		"description",
+		"protocol",
+		"source_address",
+		"source_port",
+		"destination_port",
+		"translation_address",
+		"translation_port",
+		"outbound_interface_network_id",
+		"outbound_interface_network_name",
+		"inbound_interface_network_id",
+		"inbound_interface_network_name",
+	) {
+		natTableID := d.Get("nat_table_id").(string)
+		mutexKV.Lock(natTableID)
+		defer mutexKV.Unlock(natTableID)
+
+		if _, err := svc.NiftyReplaceNatRule(ctx, expandNiftyReplaceNatRuleInput(d)); err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating nat rule: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
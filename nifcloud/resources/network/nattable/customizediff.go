@@ -0,0 +1,45 @@
+package nattable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/rawconfig"
+)
+
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+
+	for _, natType := range []string{"snat", "dnat"} {
+		if !d.HasChange(natType) {
+			continue
+		}
+
+		var ruleNumbers []string
+		for _, rule := range rawconfig.Elements(config.GetAttr(natType)) {
+			ruleNumber, _ := rawconfig.String(rule, "rule_number")
+			ruleNumbers = append(ruleNumbers, ruleNumber)
+		}
+
+		if err := validateRuleNumbers(natType, ruleNumbers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateRuleNumbers(natType string, ruleNumbers []string) error {
+	seen := make(map[string]bool, len(ruleNumbers))
+	for i, ruleNumber := range ruleNumbers {
+		if ruleNumber == "" {
+			continue
+		}
+		if seen[ruleNumber] {
+			return fmt.Errorf("%s.%d.rule_number: %q is duplicated", natType, i, ruleNumber)
+		}
+		seen[ruleNumber] = true
+	}
+	return nil
+}
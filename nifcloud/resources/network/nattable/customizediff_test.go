@@ -0,0 +1,44 @@
+package nattable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRuleNumbers(t *testing.T) {
+	tests := []struct {
+		name        string
+		ruleNumbers []string
+		wantErr     string
+	}{
+		{
+			name:        "accepts the unique rule numbers",
+			ruleNumbers: []string{"1", "2", "3"},
+		},
+		{
+			name:        "returns the path of the duplicated rule number",
+			ruleNumbers: []string{"1", "2", "1"},
+			wantErr:     `snat.2.rule_number: "1" is duplicated`,
+		},
+		{
+			name:        "ignores the unknown rule numbers",
+			ruleNumbers: []string{"", "1", ""},
+		},
+		{
+			name:        "accepts the empty rules",
+			ruleNumbers: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRuleNumbers("snat", tt.ruleNumbers)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ func New() *schema.Resource {
 		UpdateContext: update,
 		DeleteContext: delete,
 
+		CustomizeDiff: customizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -34,7 +36,7 @@ func newSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"snat": {
 			Type:        schema.TypeSet,
-			Description: "A list of snat objects.",
+			Description: "A list of snat objects. Add this to `ignore_changes` when `nifcloud_nat_rule` is used for the same nat table.",
 			Optional:    true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -92,7 +94,7 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"dnat": {
 			Type:        schema.TypeSet,
-			Description: "A list of snat objects.",
+			Description: "A list of dnat objects. Add this to `ignore_changes` when `nifcloud_nat_rule` is used for the same nat table.",
 			Optional:    true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
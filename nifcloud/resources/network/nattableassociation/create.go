@@ -0,0 +1,37 @@
+package nattableassociation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	routerID := d.Get("router_id").(string)
+	mutexkv.LockRouter(routerID)
+	defer mutexkv.UnlockRouter(routerID)
+
+	d.SetId(routerID)
+
+	if err := waitForRouterAvailable(ctx, d, svc); err != nil {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("failed waiting for router available: %s", err))
+	}
+
+	if _, err := svc.NiftyAssociateNatTable(ctx, expandNiftyAssociateNatTableInput(d)); err != nil {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("failed associating NAT table: %s", err))
+	}
+
+	if err := waitForRouterAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for router available: %s", err))
+	}
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,33 @@
+package nattableassociation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	mutexkv.LockRouter(d.Id())
+	defer mutexkv.UnlockRouter(d.Id())
+
+	if err := waitForRouterAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for router available: %s", err))
+	}
+
+	if _, err := svc.NiftyDisassociateNatTable(ctx, expandNiftyDisassociateNatTableInput(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed disassociating NAT table: %s", err))
+	}
+
+	if err := waitForRouterAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for router available: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
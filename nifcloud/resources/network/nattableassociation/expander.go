@@ -0,0 +1,33 @@
+package nattableassociation
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func expandNiftyAssociateNatTableInput(d *schema.ResourceData) *computing.NiftyAssociateNatTableInput {
+	return &computing.NiftyAssociateNatTableInput{
+		NatTableId: nifcloud.String(d.Get("nat_table_id").(string)),
+		RouterId:   nifcloud.String(d.Get("router_id").(string)),
+	}
+}
+
+func expandNiftyReplaceNatTableAssociationInput(d *schema.ResourceData) *computing.NiftyReplaceNatTableAssociationInput {
+	return &computing.NiftyReplaceNatTableAssociationInput{
+		AssociationId: nifcloud.String(d.Get("nat_table_association_id").(string)),
+		NatTableId:    nifcloud.String(d.Get("nat_table_id").(string)),
+	}
+}
+
+func expandNiftyDisassociateNatTableInput(d *schema.ResourceData) *computing.NiftyDisassociateNatTableInput {
+	return &computing.NiftyDisassociateNatTableInput{
+		AssociationId: nifcloud.String(d.Get("nat_table_association_id").(string)),
+	}
+}
+
+func expandNiftyDescribeRoutersInput(d *schema.ResourceData) *computing.NiftyDescribeRoutersInput {
+	return &computing.NiftyDescribeRoutersInput{
+		RouterId: []string{d.Id()},
+	}
+}
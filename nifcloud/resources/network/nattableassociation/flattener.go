@@ -0,0 +1,41 @@
+package nattableassociation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.NiftyDescribeRoutersOutput) error {
+	if res == nil || len(res.RouterSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	router := res.RouterSet[0]
+
+	if nifcloud.ToString(router.RouterId) != d.Id() {
+		return fmt.Errorf("unable to find router within: %#v", res.RouterSet)
+	}
+
+	if nifcloud.ToString(router.NatTableId) == "" {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("router_id", router.RouterId); err != nil {
+		return err
+	}
+
+	if err := d.Set("nat_table_id", router.NatTableId); err != nil {
+		return err
+	}
+
+	if err := d.Set("nat_table_association_id", router.NatTableAssociationId); err != nil {
+		return err
+	}
+
+	return nil
+}
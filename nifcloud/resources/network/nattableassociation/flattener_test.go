@@ -0,0 +1,101 @@
+package nattableassociation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"nat_table_id":             "test_nat_table_id",
+		"router_id":                "test_router_id",
+		"nat_table_association_id": "test_nat_table_association_id",
+	})
+	rd.SetId("test_router_id")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantDisassociatedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	wantDisassociatedRd.SetId("test_router_id")
+
+	type args struct {
+		res *computing.NiftyDescribeRoutersOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId:              nifcloud.String("test_router_id"),
+							NatTableId:            nifcloud.String("test_nat_table_id"),
+							NatTableAssociationId: nifcloud.String("test_nat_table_association_id"),
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the NAT table has been disassociated externally",
+			args: args{
+				d: wantDisassociatedRd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId: nifcloud.String("test_router_id"),
+						},
+					},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
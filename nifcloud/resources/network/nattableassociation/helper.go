@@ -0,0 +1,14 @@
+package nattableassociation
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func waitForRouterAvailable(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	deadline, _ := ctx.Deadline()
+	return computing.NewRouterAvailableWaiter(svc).Wait(ctx, expandNiftyDescribeRoutersInput(d), time.Until(deadline))
+}
@@ -0,0 +1,50 @@
+package nattableassociation
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Provides a NAT table association resource."
+
+// New returns the nifcloud_nat_table_association resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"nat_table_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the NAT table to associate.",
+			Required:    true,
+		},
+		"router_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the router to associate with the NAT table.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"nat_table_association_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the NAT table association.",
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package nattableassociation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d.HasChange("nat_table_id") {
+		mutexkv.LockRouter(d.Id())
+		defer mutexkv.UnlockRouter(d.Id())
+
+		if err := waitForRouterAvailable(ctx, d, svc); err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for router available: %s", err))
+		}
+
+		if _, err := svc.NiftyReplaceNatTableAssociation(ctx, expandNiftyReplaceNatTableAssociationInput(d)); err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating NAT table association: %s", err))
+		}
+
+		if err := waitForRouterAvailable(ctx, d, svc); err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for router available: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
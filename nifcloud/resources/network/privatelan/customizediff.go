@@ -0,0 +1,31 @@
+package privatelan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/rawconfig"
+)
+
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("cidr_block") {
+		return nil
+	}
+
+	cidrBlock, ok := rawconfig.String(d.GetRawConfig(), "cidr_block")
+	if !ok {
+		return d.SetNewComputed("dependents_outside_cidr")
+	}
+
+	svc := meta.(*client.Client).Computing
+
+	lan, err := describePrivateLan(ctx, svc, d.Id())
+	if err != nil {
+		return fmt.Errorf("failed reading private_lan dependents: %s", err)
+	}
+
+	dependents := dependentsOutsideCIDR(collectDependents(*lan), cidrBlock)
+	return d.SetNew("dependents_outside_cidr", flattenDependents(dependents))
+}
@@ -48,5 +48,10 @@ func flatten(d *schema.ResourceData, res *computing.NiftyDescribePrivateLansOutp
 		return err
 	}
 
+	dependents := dependentsOutsideCIDR(collectDependents(privateLan), nifcloud.ToString(privateLan.CidrBlock))
+	if err := d.Set("dependents_outside_cidr", flattenDependents(dependents)); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,180 @@
+package privatelan
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/cidr"
+)
+
+// dependent is a device attached to the private lan.
+type dependent struct {
+	kind      string
+	id        string
+	ipAddress string
+}
+
+func (d dependent) String() string {
+	if d.ipAddress == "" {
+		return fmt.Sprintf("%s %s", d.kind, d.id)
+	}
+	return fmt.Sprintf("%s %s (%s)", d.kind, d.id, d.ipAddress)
+}
+
+func collectDependents(lan types.PrivateLanSet) []dependent {
+	var dependents []dependent
+
+	for _, r := range lan.RouterSet {
+		dependents = append(dependents, dependent{
+			kind:      "router",
+			id:        nifcloud.ToString(r.RouterId),
+			ipAddress: nifcloud.ToString(r.IpAddress),
+		})
+	}
+
+	for _, v := range lan.VpnGatewaySet {
+		dependents = append(dependents, dependent{
+			kind:      "vpn_gateway",
+			id:        nifcloud.ToString(v.VpnGatewayId),
+			ipAddress: nifcloud.ToString(v.IpAddress),
+		})
+	}
+
+	for _, v := range lan.RemoteAccessVpnGatewaySet {
+		dependents = append(dependents, dependent{
+			kind:      "remote_access_vpn_gateway",
+			id:        nifcloud.ToString(v.RemoteAccessVpnGatewayId),
+			ipAddress: nifcloud.ToString(v.IpAddress),
+		})
+	}
+
+	for _, e := range lan.ElasticLoadBalancingSet {
+		dependents = append(dependents, dependent{
+			kind: "elb",
+			id:   nifcloud.ToString(e.ElasticLoadBalancerName),
+		})
+	}
+
+	for _, i := range lan.InstancesSet {
+		dependents = append(dependents, dependent{
+			kind:      "instance",
+			id:        nifcloud.ToString(i.InstanceId),
+			ipAddress: nifcloud.ToString(i.IpAddress),
+		})
+	}
+
+	for _, n := range lan.NetworkInterfaceSet {
+		dependents = append(dependents, dependent{
+			kind:      "network_interface",
+			id:        nifcloud.ToString(n.NetworkInterfaceId),
+			ipAddress: nifcloud.ToString(n.IpAddress),
+		})
+	}
+
+	return dependents
+}
+
+// dependentsOutsideCIDR returns the dependents which have a static IP address outside the CIDR block.
+// The dependents without an IP address or with an address assigned by DHCP are skipped.
+func dependentsOutsideCIDR(dependents []dependent, cidrBlock string) []dependent {
+	var res []dependent
+	for _, d := range dependents {
+		if net.ParseIP(d.ipAddress) == nil {
+			continue
+		}
+		if !cidr.Contains(cidrBlock, d.ipAddress) {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+func flattenDependents(dependents []dependent) []string {
+	res := make([]string, 0, len(dependents))
+	for _, d := range dependents {
+		res = append(res, d.String())
+	}
+	return res
+}
+
+func describePrivateLan(ctx context.Context, svc *computing.Client, networkID string) (*types.PrivateLanSet, error) {
+	res, err := svc.NiftyDescribePrivateLans(ctx, &computing.NiftyDescribePrivateLansInput{
+		NetworkId: []string{networkID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.PrivateLanSet) == 0 {
+		return nil, fmt.Errorf("unable to find private lan %s", networkID)
+	}
+
+	return &res.PrivateLanSet[0], nil
+}
+
+// waitForDependentsAvailable waits until the private lan and the routers, vpn gateways and elbs attached to it become available.
+// Instances have no state to wait for while the private lan is updated.
+func waitForDependentsAvailable(ctx context.Context, svc *computing.Client, lan *types.PrivateLanSet, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	var routerIDs []string
+	for _, r := range lan.RouterSet {
+		routerIDs = append(routerIDs, nifcloud.ToString(r.RouterId))
+	}
+	if len(routerIDs) > 0 {
+		err := computing.NewRouterAvailableWaiter(svc).Wait(ctx, &computing.NiftyDescribeRoutersInput{
+			RouterId: routerIDs,
+		}, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("failed waiting for routers to become available: %s", err)
+		}
+	}
+
+	var vpnGatewayIDs []string
+	for _, v := range lan.VpnGatewaySet {
+		vpnGatewayIDs = append(vpnGatewayIDs, nifcloud.ToString(v.VpnGatewayId))
+	}
+	if len(vpnGatewayIDs) > 0 {
+		err := computing.NewVpnGatewayAvailableWaiter(svc).Wait(ctx, &computing.DescribeVpnGatewaysInput{
+			VpnGatewayId: vpnGatewayIDs,
+		}, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("failed waiting for vpn gateways to become available: %s", err)
+		}
+	}
+
+	// the elb is listed for each of its listeners.
+	var elbNames []string
+	seen := make(map[string]bool)
+	for _, e := range lan.ElasticLoadBalancingSet {
+		name := nifcloud.ToString(e.ElasticLoadBalancerName)
+		if !seen[name] {
+			seen[name] = true
+			elbNames = append(elbNames, name)
+		}
+	}
+	if len(elbNames) > 0 {
+		err := computing.NewElasticLoadBalancerAvailableWaiter(svc).Wait(ctx, &computing.NiftyDescribeElasticLoadBalancersInput{
+			ElasticLoadBalancers: &types.RequestElasticLoadBalancers{
+				ListOfRequestElasticLoadBalancerName: elbNames,
+			},
+		}, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("failed waiting for elbs to become available: %s", err)
+		}
+	}
+
+	err := computing.NewPrivateLanAvailableWaiter(svc).Wait(ctx, &computing.NiftyDescribePrivateLansInput{
+		NetworkId: []string{nifcloud.ToString(lan.NetworkId)},
+	}, time.Until(deadline))
+	if err != nil {
+		return fmt.Errorf("failed waiting for private_lan to become available: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package privatelan
+
+import (
+	"testing"
+
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectDependents(t *testing.T) {
+	lan := types.PrivateLanSet{
+		RouterSet: []types.RouterSetOfNiftyDescribePrivateLans{
+			{RouterId: nifcloud.String("test_router_id"), IpAddress: nifcloud.String("192.168.1.1")},
+		},
+		VpnGatewaySet: []types.VpnGatewaySetOfNiftyDescribePrivateLans{
+			{VpnGatewayId: nifcloud.String("test_vpn_gateway_id"), IpAddress: nifcloud.String("192.168.1.2")},
+		},
+		RemoteAccessVpnGatewaySet: []types.RemoteAccessVpnGatewaySetOfNiftyDescribePrivateLans{
+			{RemoteAccessVpnGatewayId: nifcloud.String("test_remote_access_vpn_gateway_id"), IpAddress: nifcloud.String("192.168.1.3")},
+		},
+		ElasticLoadBalancingSet: []types.ElasticLoadBalancingOfNiftyDescribeAutoScalingGroupsSet{
+			{ElasticLoadBalancerName: nifcloud.String("test_elb_name")},
+		},
+		InstancesSet: []types.InstancesSetOfNiftyDescribePrivateLans{
+			{InstanceId: nifcloud.String("test_instance_id"), IpAddress: nifcloud.String("DHCP")},
+		},
+		NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribePrivateLans{
+			{NetworkInterfaceId: nifcloud.String("test_network_interface_id"), IpAddress: nifcloud.String("192.168.1.4")},
+		},
+	}
+
+	want := []dependent{
+		{kind: "router", id: "test_router_id", ipAddress: "192.168.1.1"},
+		{kind: "vpn_gateway", id: "test_vpn_gateway_id", ipAddress: "192.168.1.2"},
+		{kind: "remote_access_vpn_gateway", id: "test_remote_access_vpn_gateway_id", ipAddress: "192.168.1.3"},
+		{kind: "elb", id: "test_elb_name"},
+		{kind: "instance", id: "test_instance_id", ipAddress: "DHCP"},
+		{kind: "network_interface", id: "test_network_interface_id", ipAddress: "192.168.1.4"},
+	}
+
+	assert.Equal(t, want, collectDependents(lan))
+}
+
+func TestDependentsOutsideCIDR(t *testing.T) {
+	dependents := []dependent{
+		{kind: "router", id: "test_router_id", ipAddress: "192.168.1.1"},
+		{kind: "vpn_gateway", id: "test_vpn_gateway_id", ipAddress: "192.168.2.1"},
+		{kind: "elb", id: "test_elb_name"},
+		{kind: "instance", id: "test_instance_id", ipAddress: "DHCP"},
+	}
+
+	tests := []struct {
+		name      string
+		cidrBlock string
+		want      []dependent
+	}{
+		{
+			name:      "returns the dependents outside the cidr block",
+			cidrBlock: "192.168.1.0/24",
+			want: []dependent{
+				{kind: "vpn_gateway", id: "test_vpn_gateway_id", ipAddress: "192.168.2.1"},
+			},
+		},
+		{
+			name:      "returns nothing when all dependents are within the cidr block",
+			cidrBlock: "192.168.0.0/16",
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dependentsOutsideCIDR(dependents, tt.cidrBlock))
+		})
+	}
+}
+
+func TestFlattenDependents(t *testing.T) {
+	dependents := []dependent{
+		{kind: "router", id: "test_router_id", ipAddress: "192.168.1.1"},
+		{kind: "elb", id: "test_elb_name"},
+	}
+
+	want := []string{
+		"router test_router_id (192.168.1.1)",
+		"elb test_elb_name",
+	}
+
+	assert.Equal(t, want, flattenDependents(dependents))
+}
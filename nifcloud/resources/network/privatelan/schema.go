@@ -22,6 +22,8 @@ func New() *schema.Resource {
 		UpdateContext: update,
 		DeleteContext: delete,
 
+		CustomizeDiff: customizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -76,6 +78,20 @@ func newSchema() map[string]*schema.Schema {
 			Optional:         true,
 			ValidateDiagFunc: validator.StringRuneCountBetween(0, 40),
 		},
+		"wait_for_dependents": {
+			Type:        schema.TypeBool,
+			Description: "If true, the cidr_block update waits for the private lan and the routers, vpn gateways and elbs attached to it to become available before and after the update. Instances are not waited for.",
+			Optional:    true,
+			Default:     false,
+		},
+		"dependents_outside_cidr": {
+			Type:        schema.TypeList,
+			Description: "The devices attached to the private lan whose IP address is outside the cidr_block. On a cidr_block update, the plan shows the devices whose IP address must be changed.",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 		"state": {
 			Type:        schema.TypeString,
 			Description: "The state of the private lan.",
@@ -3,6 +3,7 @@ package privatelan
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,6 +11,8 @@ import (
 )
 
 func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	if d.HasChange("private_lan_name") {
 		input := expandNiftyModifyPrivateLanAttributeInputForPrivateLanName(d)
 
@@ -26,10 +29,41 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 
 		svc := meta.(*client.Client).Computing
 
-		_, err := svc.NiftyModifyPrivateLanAttribute(ctx, input)
+		lan, err := describePrivateLan(ctx, svc, d.Id())
 		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed reading private_lan dependents: %s", err))
+		}
+
+		waitForDependents := d.Get("wait_for_dependents").(bool)
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+
+		if waitForDependents {
+			if err := waitForDependentsAvailable(ctx, svc, lan, time.Until(deadline)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if _, err := svc.NiftyModifyPrivateLanAttribute(ctx, input); err != nil {
 			return diag.FromErr(fmt.Errorf("failed updating private_lan cidr_block: %s", err))
 		}
+
+		if waitForDependents {
+			if err := waitForDependentsAvailable(ctx, svc, lan, time.Until(deadline)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		cidrBlock := d.Get("cidr_block").(string)
+		for _, dep := range dependentsOutsideCIDR(collectDependents(*lan), cidrBlock) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("IP address of %s is outside the cidr_block", dep.kind),
+				Detail: fmt.Sprintf(
+					"The IP address of %s must be changed to be within the new cidr_block %q.",
+					dep, cidrBlock,
+				),
+			})
+		}
 	}
 
 	if d.HasChange("accounting_type") {
@@ -53,5 +87,5 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 			return diag.FromErr(fmt.Errorf("failed updating private_lan description: %s", err))
 		}
 	}
-	return read(ctx, d, meta)
+	return append(diags, read(ctx, d, meta)...)
 }
@@ -0,0 +1,26 @@
+package route
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	routeTableID := d.Get("route_table_id").(string)
+	mutexKV.Lock(routeTableID)
+	defer mutexKV.Unlock(routeTableID)
+
+	if _, err := svc.CreateRoute(ctx, expandCreateRouteInput(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed creating route: %s", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", routeTableID, d.Get("cidr_block").(string)))
+
+	return read(ctx, d, meta)
+}
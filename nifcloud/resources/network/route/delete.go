@@ -0,0 +1,32 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	routeTableID := d.Get("route_table_id").(string)
+	mutexKV.Lock(routeTableID)
+	defer mutexKV.Unlock(routeTableID)
+
+	if _, err := svc.DeleteRoute(ctx, expandDeleteRouteInput(d)); err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.RouteTableId" {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed deleting route: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,40 @@
+package route
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func expandCreateRouteInput(d *schema.ResourceData) *computing.CreateRouteInput {
+	return &computing.CreateRouteInput{
+		RouteTableId:         nifcloud.String(d.Get("route_table_id").(string)),
+		DestinationCidrBlock: nifcloud.String(d.Get("cidr_block").(string)),
+		IpAddress:            nifcloud.String(d.Get("ip_address").(string)),
+		NetworkId:            nifcloud.String(d.Get("network_id").(string)),
+		NetworkName:          nifcloud.String(d.Get("network_name").(string)),
+	}
+}
+
+func expandReplaceRouteInput(d *schema.ResourceData) *computing.ReplaceRouteInput {
+	return &computing.ReplaceRouteInput{
+		RouteTableId:         nifcloud.String(d.Get("route_table_id").(string)),
+		DestinationCidrBlock: nifcloud.String(d.Get("cidr_block").(string)),
+		IpAddress:            nifcloud.String(d.Get("ip_address").(string)),
+		NetworkId:            nifcloud.String(d.Get("network_id").(string)),
+		NetworkName:          nifcloud.String(d.Get("network_name").(string)),
+	}
+}
+
+func expandDeleteRouteInput(d *schema.ResourceData) *computing.DeleteRouteInput {
+	return &computing.DeleteRouteInput{
+		RouteTableId:         nifcloud.String(d.Get("route_table_id").(string)),
+		DestinationCidrBlock: nifcloud.String(d.Get("cidr_block").(string)),
+	}
+}
+
+func expandDescribeRouteTablesInput(d *schema.ResourceData) *computing.DescribeRouteTablesInput {
+	return &computing.DescribeRouteTablesInput{
+		RouteTableId: []string{d.Get("route_table_id").(string)},
+	}
+}
@@ -0,0 +1,128 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandCreateRouteInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id": "test_route_table_id",
+		"cidr_block":     "10.0.1.0/24",
+		"ip_address":     "192.168.1.254",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.CreateRouteInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.CreateRouteInput{
+				RouteTableId:         nifcloud.String("test_route_table_id"),
+				DestinationCidrBlock: nifcloud.String("10.0.1.0/24"),
+				IpAddress:            nifcloud.String("192.168.1.254"),
+				NetworkId:            nifcloud.String(""),
+				NetworkName:          nifcloud.String(""),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandCreateRouteInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandReplaceRouteInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id": "test_route_table_id",
+		"cidr_block":     "10.0.1.0/24",
+		"network_id":     "net-COMMON_GLOBAL",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.ReplaceRouteInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.ReplaceRouteInput{
+				RouteTableId:         nifcloud.String("test_route_table_id"),
+				DestinationCidrBlock: nifcloud.String("10.0.1.0/24"),
+				IpAddress:            nifcloud.String(""),
+				NetworkId:            nifcloud.String("net-COMMON_GLOBAL"),
+				NetworkName:          nifcloud.String(""),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandReplaceRouteInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDeleteRouteInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id": "test_route_table_id",
+		"cidr_block":     "10.0.1.0/24",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DeleteRouteInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DeleteRouteInput{
+				RouteTableId:         nifcloud.String("test_route_table_id"),
+				DestinationCidrBlock: nifcloud.String("10.0.1.0/24"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDeleteRouteInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeRouteTablesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id": "test_route_table_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeRouteTablesInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeRouteTablesInput{
+				RouteTableId: []string{"test_route_table_id"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeRouteTablesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
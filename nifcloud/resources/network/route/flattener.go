@@ -0,0 +1,65 @@
+package route
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeRouteTablesOutput) error {
+	if res == nil || len(res.RouteTableSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	routeTable := res.RouteTableSet[0]
+
+	if nifcloud.ToString(routeTable.RouteTableId) != d.Get("route_table_id").(string) {
+		return fmt.Errorf("unable to find route table within: %#v", res.RouteTableSet)
+	}
+
+	var route *types.RouteSet
+	for _, r := range routeTable.RouteSet {
+		// for vpn connection of IPsec VTI
+		if nifcloud.ToString(r.Origin) == "EnableVgwRoutePropagation" {
+			continue
+		}
+
+		if nifcloud.ToString(r.DestinationCidrBlock) == d.Get("cidr_block").(string) {
+			r := r
+			route = &r
+			break
+		}
+	}
+
+	if route == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("ip_address", route.IpAddress); err != nil {
+		return err
+	}
+
+	// the API returns both network_id and network_name; keep the one that is configured,
+	// or both of them after import.
+	networkID := d.Get("network_id").(string)
+	networkName := d.Get("network_name").(string)
+
+	if networkID != "" || networkName == "" {
+		if err := d.Set("network_id", route.NetworkId); err != nil {
+			return err
+		}
+	}
+
+	if networkName != "" || networkID == "" {
+		if err := d.Set("network_name", route.NetworkName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id": "test_route_table_id",
+		"cidr_block":     "10.0.1.0/24",
+		"network_name":   "test_network_name",
+	})
+	rd.SetId("test_route_table_id_10.0.1.0/24")
+
+	importedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id": "test_route_table_id",
+		"cidr_block":     "10.0.1.0/24",
+	})
+	importedRd.SetId("test_route_table_id_10.0.1.0/24")
+
+	wantImportedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id": "test_route_table_id",
+		"cidr_block":     "10.0.1.0/24",
+		"network_id":     "test_network_id",
+		"network_name":   "test_network_name",
+	})
+	wantImportedRd.SetId("test_route_table_id_10.0.1.0/24")
+	assert.NoError(t, wantImportedRd.Set("ip_address", ""))
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantRemovedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id": "test_route_table_id",
+		"cidr_block":     "10.0.1.0/24",
+	})
+	wantRemovedRd.SetId("test_route_table_id_10.0.1.0/24")
+
+	wantRemovedStateRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id": "test_route_table_id",
+		"cidr_block":     "10.0.1.0/24",
+	})
+
+	type args struct {
+		res *computing.DescribeRouteTablesOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.DescribeRouteTablesOutput{
+					RouteTableSet: []types.RouteTableSet{
+						{
+							RouteTableId: nifcloud.String("test_route_table_id"),
+							RouteSet: []types.RouteSet{
+								{
+									DestinationCidrBlock: nifcloud.String("10.0.2.0/24"),
+									IpAddress:            nifcloud.String("192.168.1.254"),
+								},
+								{
+									DestinationCidrBlock: nifcloud.String("10.0.1.0/24"),
+									NetworkId:            nifcloud.String("test_network_id"),
+									NetworkName:          nifcloud.String("test_network_name"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens both the network id and name after import",
+			args: args{
+				d: importedRd,
+				res: &computing.DescribeRouteTablesOutput{
+					RouteTableSet: []types.RouteTableSet{
+						{
+							RouteTableId: nifcloud.String("test_route_table_id"),
+							RouteSet: []types.RouteSet{
+								{
+									DestinationCidrBlock: nifcloud.String("10.0.1.0/24"),
+									NetworkId:            nifcloud.String("test_network_id"),
+									NetworkName:          nifcloud.String("test_network_name"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: wantImportedRd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.DescribeRouteTablesOutput{
+					RouteTableSet: []types.RouteTableSet{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the route has been removed externally",
+			args: args{
+				d: wantRemovedRd,
+				res: &computing.DescribeRouteTablesOutput{
+					RouteTableSet: []types.RouteTableSet{
+						{
+							RouteTableId: nifcloud.String("test_route_table_id"),
+							RouteSet: []types.RouteSet{
+								{
+									DestinationCidrBlock: nifcloud.String("10.0.2.0/24"),
+									IpAddress:            nifcloud.String("192.168.1.254"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: wantRemovedStateRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
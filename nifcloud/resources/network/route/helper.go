@@ -0,0 +1,30 @@
+package route
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+var mutexKV = mutexkv.NewMutexKV()
+
+func populateFromImport(d *schema.ResourceData) error {
+	// example: rtb-abcdefgh_10.0.1.0/24
+
+	parts := strings.SplitN(d.Id(), "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("unexpected format of import string (%q), expected ROUTETABLEID_CIDRBLOCK", d.Id())
+	}
+
+	if err := d.Set("route_table_id", parts[0]); err != nil {
+		return err
+	}
+
+	if err := d.Set("cidr_block", parts[1]); err != nil {
+		return err
+	}
+
+	return nil
+}
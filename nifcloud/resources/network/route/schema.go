@@ -0,0 +1,79 @@
+package route
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/rawconfig"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Provides a route resource."
+
+// New returns the nifcloud_route resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := populateFromImport(d); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"route_table_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the route table.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"cidr_block": {
+			Type:        schema.TypeString,
+			Description: "The destination IP address or CIDR.",
+			Required:    true,
+			ForceNew:    true,
+			ValidateDiagFunc: validator.Any(
+				validator.CIDRNetworkAddress,
+				validator.IPAddress,
+			),
+		},
+		"ip_address": {
+			Type:             schema.TypeString,
+			Description:      "The target IP address.",
+			Optional:         true,
+			ExactlyOneOf:     []string{"ip_address", "network_id", "network_name"},
+			ValidateDiagFunc: validator.IPAddress,
+		},
+		"network_id": {
+			Type:             schema.TypeString,
+			Description:      "The id of target network; 'net-COMMON_GLOBAL' or `net-COMMON_PRIVATE` or private lan network id.",
+			Optional:         true,
+			ExactlyOneOf:     []string{"ip_address", "network_id", "network_name"},
+			DiffSuppressFunc: rawconfig.SuppressRemovalIfConfigured("network_name"),
+		},
+		"network_name": {
+			Type:             schema.TypeString,
+			Description:      "The private lan name of target network.",
+			Optional:         true,
+			ExactlyOneOf:     []string{"ip_address", "network_id", "network_name"},
+			DiffSuppressFunc: rawconfig.SuppressRemovalIfConfigured("network_id"),
+		},
+	}
+}
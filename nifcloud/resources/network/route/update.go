@@ -0,0 +1,26 @@
+package route
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d.HasChanges("ip_address", "network_id", "network_name") {
+		routeTableID := d.Get("route_table_id").(string)
+		mutexKV.Lock(routeTableID)
+		defer mutexKV.Unlock(routeTableID)
+
+		if _, err := svc.ReplaceRoute(ctx, expandReplaceRouteInput(d)); err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating route: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,56 @@
+package router
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/rawconfig"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/routerinterface"
+)
+
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("network_interface") {
+		return nil
+	}
+
+	svc := meta.(*client.Client).Computing
+
+	o, _ := d.GetChange("network_interface")
+	attached := map[string]string{}
+	for _, ni := range o.(*schema.Set).List() {
+		m := ni.(map[string]interface{})
+		for _, key := range []string{m["network_id"].(string), m["network_name"].(string)} {
+			if key != "" {
+				attached[key] = m["dhcp_config_id"].(string)
+			}
+		}
+	}
+
+	for i, ni := range rawconfig.Elements(d.GetRawConfig().GetAttr("network_interface")) {
+		networkID, _ := rawconfig.String(ni, "network_id")
+		networkName, _ := rawconfig.String(ni, "network_name")
+		ipAddress, _ := rawconfig.String(ni, "ip_address")
+		dhcpConfigID, _ := rawconfig.String(ni, "dhcp_config_id")
+
+		priorDhcpConfigID, ok := attached[networkID]
+		if !ok {
+			priorDhcpConfigID, ok = attached[networkName]
+		}
+		if ok {
+			// The ip_address of the existing attachment may be moved together with
+			// the cidr_block of the private lan in the same apply, which can not be seen here.
+			if dhcpConfigID == priorDhcpConfigID {
+				continue
+			}
+			ipAddress = ""
+		}
+
+		if err := routerinterface.Validate(ctx, svc, networkID, networkName, ipAddress, dhcpConfigID); err != nil {
+			return fmt.Errorf("network_interface.%d.%s", i, err)
+		}
+	}
+
+	return nil
+}
@@ -11,12 +11,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
 )
 
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	svc := meta.(*client.Client).Computing
 	deadline, _ := ctx.Deadline()
 
+	mutexkv.LockRouter(d.Id())
+	defer mutexkv.UnlockRouter(d.Id())
+
 	describeRoutersInput := expandNiftyDescribeRoutersInput(d)
 	if _, err := svc.NiftyDescribeRouters(ctx, describeRoutersInput); err != nil {
 		var awsErr smithy.APIError
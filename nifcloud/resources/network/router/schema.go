@@ -22,6 +22,8 @@ func New() *schema.Resource {
 		UpdateContext: update,
 		DeleteContext: delete,
 
+		CustomizeDiff: customizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -73,7 +75,7 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"nat_table_id": {
 			Type:        schema.TypeString,
-			Description: "The ID of the NAT table to attach.",
+			Description: "The ID of the NAT table to attach. Add this to `ignore_changes` when `nifcloud_nat_table_association` is used for the router.",
 			Optional:    true,
 		},
 		"network_interface": {
@@ -128,7 +130,7 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"route_table_id": {
 			Type:        schema.TypeString,
-			Description: "The ID of the route table to attach.",
+			Description: "The ID of the route table to attach. Add this to `ignore_changes` when `nifcloud_route_table_association` is used for the router.",
 			Optional:    true,
 		},
 		"security_group": {
@@ -17,6 +17,9 @@ const waiterInitialDelay = 3
 func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	svc := meta.(*client.Client).Computing
 
+	mutexkv.LockRouter(d.Id())
+	defer mutexkv.UnlockRouter(d.Id())
+
 	if d.HasChange("accounting_type") {
 		input := expandNiftyModifyRouterAttributeInputForAccountingType(d)
 
@@ -0,0 +1,48 @@
+package routernetworkinterface
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.NiftyDescribeRouters(ctx, expandNiftyDescribeRoutersInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading router: %s", err))
+	}
+
+	if res != nil && len(res.RouterSet) > 0 {
+		for _, ni := range res.RouterSet[0].NetworkInterfaceSet {
+			if isTargetNetworkInterface(d, ni) {
+				return diag.Errorf("the network is already attached to the router %s", d.Get("router_id").(string))
+			}
+		}
+	}
+
+	if d := updateRouterNetworkInterfaces(ctx, d, svc, false); d != nil {
+		return d
+	}
+
+	res, err = svc.NiftyDescribeRouters(ctx, expandNiftyDescribeRoutersInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading router: %s", err))
+	}
+
+	if len(res.RouterSet) > 0 {
+		for _, ni := range res.RouterSet[0].NetworkInterfaceSet {
+			if isTargetNetworkInterface(d, ni) {
+				d.SetId(fmt.Sprintf("%s_%s", d.Get("router_id").(string), nifcloud.ToString(ni.NetworkId)))
+				return read(ctx, d, meta)
+			}
+		}
+	}
+
+	return diag.Errorf("unable to find the attached network interface in the router %s", d.Get("router_id").(string))
+}
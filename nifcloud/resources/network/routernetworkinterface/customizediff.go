@@ -0,0 +1,35 @@
+package routernetworkinterface
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/rawconfig"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/routerinterface"
+)
+
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("network_id", "network_name", "ip_address", "dhcp_config_id") {
+		return nil
+	}
+
+	svc := meta.(*client.Client).Computing
+	config := d.GetRawConfig()
+
+	networkID, _ := rawconfig.String(config, "network_id")
+	networkName, _ := rawconfig.String(config, "network_name")
+	ipAddress, _ := rawconfig.String(config, "ip_address")
+	dhcpConfigID, _ := rawconfig.String(config, "dhcp_config_id")
+
+	if d.Id() != "" && !d.HasChanges("network_id", "network_name") {
+		// Only the dhcp config is checked for the existing interface, since its ip_address
+		// may follow a cidr_block change of the private lan planned in the same apply.
+		if !d.HasChange("dhcp_config_id") {
+			return nil
+		}
+		ipAddress = ""
+	}
+
+	return routerinterface.Validate(ctx, svc, networkID, networkName, ipAddress, dhcpConfigID)
+}
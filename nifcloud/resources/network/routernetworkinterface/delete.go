@@ -0,0 +1,20 @@
+package routernetworkinterface
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d := updateRouterNetworkInterfaces(ctx, d, svc, true); d != nil {
+		return d
+	}
+
+	d.SetId("")
+	return nil
+}
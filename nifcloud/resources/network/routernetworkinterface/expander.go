@@ -0,0 +1,73 @@
+package routernetworkinterface
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandNiftyDescribeRoutersInput(d *schema.ResourceData) *computing.NiftyDescribeRoutersInput {
+	return &computing.NiftyDescribeRoutersInput{
+		RouterId: []string{d.Get("router_id").(string)},
+	}
+}
+
+func expandNiftyUpdateRouterNetworkInterfacesInput(
+	d *schema.ResourceData,
+	res *computing.NiftyDescribeRoutersOutput,
+	remove bool,
+) (*computing.NiftyUpdateRouterNetworkInterfacesInput, error) {
+	if res == nil || len(res.RouterSet) == 0 {
+		return nil, fmt.Errorf("unable to find router: %s", d.Get("router_id").(string))
+	}
+
+	var networkInterface []types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces
+	for _, ni := range res.RouterSet[0].NetworkInterfaceSet {
+		if isTargetNetworkInterface(d, ni) {
+			continue
+		}
+		networkInterface = append(networkInterface, types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces{
+			NetworkId:     ni.NetworkId,
+			IpAddress:     ni.IpAddress,
+			Dhcp:          ni.Dhcp,
+			DhcpConfigId:  ni.DhcpConfigId,
+			DhcpOptionsId: ni.DhcpOptionsId,
+		})
+	}
+
+	if !remove {
+		networkInterface = append(networkInterface, expandNetworkInterface(d))
+	}
+
+	return &computing.NiftyUpdateRouterNetworkInterfacesInput{
+		RouterId:         nifcloud.String(d.Get("router_id").(string)),
+		NetworkInterface: networkInterface,
+	}, nil
+}
+
+func expandNetworkInterface(d *schema.ResourceData) types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces {
+	n := types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces{
+		IpAddress:     nifcloud.String(d.Get("ip_address").(string)),
+		Dhcp:          nifcloud.Bool(d.Get("dhcp").(bool)),
+		DhcpConfigId:  nifcloud.String(d.Get("dhcp_config_id").(string)),
+		DhcpOptionsId: nifcloud.String(d.Get("dhcp_options_id").(string)),
+	}
+
+	if v, ok := d.GetOk("network_id"); ok {
+		n.NetworkId = nifcloud.String(v.(string))
+	} else {
+		n.NetworkName = nifcloud.String(d.Get("network_name").(string))
+	}
+
+	return n
+}
+
+func isTargetNetworkInterface(d *schema.ResourceData, ni types.NetworkInterfaceSetOfNiftyDescribeRouters) bool {
+	if v, ok := d.GetOk("network_id"); ok {
+		return nifcloud.ToString(ni.NetworkId) == v.(string)
+	}
+	return nifcloud.ToString(ni.NetworkName) == d.Get("network_name").(string)
+}
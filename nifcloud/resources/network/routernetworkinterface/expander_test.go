@@ -0,0 +1,168 @@
+package routernetworkinterface
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandNiftyDescribeRoutersInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id": "test_router_id",
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeRoutersInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeRoutersInput{
+				RouterId: []string{"test_router_id"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeRoutersInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyUpdateRouterNetworkInterfacesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id":       "test_router_id",
+		"network_id":      "test_network_id",
+		"ip_address":      "192.168.1.1",
+		"dhcp":            true,
+		"dhcp_config_id":  "test_dhcp_config_id",
+		"dhcp_options_id": "test_dhcp_options_id",
+	})
+
+	rdByName := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id":    "test_router_id",
+		"network_name": "test_network_name",
+		"ip_address":   "192.168.1.1",
+		"dhcp":         false,
+	})
+
+	res := &computing.NiftyDescribeRoutersOutput{
+		RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+			{
+				RouterId: nifcloud.String("test_router_id"),
+				NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribeRouters{
+					{
+						NetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+						IpAddress: nifcloud.String("192.0.2.1"),
+						Dhcp:      nifcloud.Bool(false),
+					},
+					{
+						NetworkId:   nifcloud.String("test_network_id"),
+						NetworkName: nifcloud.String("test_network_name"),
+						IpAddress:   nifcloud.String("192.168.1.254"),
+						Dhcp:        nifcloud.Bool(false),
+					},
+				},
+			},
+		},
+	}
+
+	globalNetworkInterface := types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces{
+		NetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+		IpAddress: nifcloud.String("192.0.2.1"),
+		Dhcp:      nifcloud.Bool(false),
+	}
+
+	type args struct {
+		d      *schema.ResourceData
+		res    *computing.NiftyDescribeRoutersOutput
+		remove bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *computing.NiftyUpdateRouterNetworkInterfacesInput
+		wantErr bool
+	}{
+		{
+			name: "expands the resource data replacing the existing network interface",
+			args: args{
+				d:   rd,
+				res: res,
+			},
+			want: &computing.NiftyUpdateRouterNetworkInterfacesInput{
+				RouterId: nifcloud.String("test_router_id"),
+				NetworkInterface: []types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces{
+					globalNetworkInterface,
+					{
+						NetworkId:     nifcloud.String("test_network_id"),
+						IpAddress:     nifcloud.String("192.168.1.1"),
+						Dhcp:          nifcloud.Bool(true),
+						DhcpConfigId:  nifcloud.String("test_dhcp_config_id"),
+						DhcpOptionsId: nifcloud.String("test_dhcp_options_id"),
+					},
+				},
+			},
+		},
+		{
+			name: "expands the resource data with network_name",
+			args: args{
+				d:   rdByName,
+				res: res,
+			},
+			want: &computing.NiftyUpdateRouterNetworkInterfacesInput{
+				RouterId: nifcloud.String("test_router_id"),
+				NetworkInterface: []types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces{
+					globalNetworkInterface,
+					{
+						NetworkName:   nifcloud.String("test_network_name"),
+						IpAddress:     nifcloud.String("192.168.1.1"),
+						Dhcp:          nifcloud.Bool(false),
+						DhcpConfigId:  nifcloud.String(""),
+						DhcpOptionsId: nifcloud.String(""),
+					},
+				},
+			},
+		},
+		{
+			name: "expands the resource data removing the network interface",
+			args: args{
+				d:      rd,
+				res:    res,
+				remove: true,
+			},
+			want: &computing.NiftyUpdateRouterNetworkInterfacesInput{
+				RouterId: nifcloud.String("test_router_id"),
+				NetworkInterface: []types.RequestNetworkInterfaceOfNiftyUpdateRouterNetworkInterfaces{
+					globalNetworkInterface,
+				},
+			},
+		},
+		{
+			name: "returns an error when the router is not found",
+			args: args{
+				d:   rd,
+				res: &computing.NiftyDescribeRoutersOutput{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandNiftyUpdateRouterNetworkInterfacesInput(tt.args.d, tt.args.res, tt.args.remove)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package routernetworkinterface
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, res *computing.NiftyDescribeRoutersOutput) error {
+	if res == nil || len(res.RouterSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	router := res.RouterSet[0]
+
+	if nifcloud.ToString(router.RouterId) != d.Get("router_id").(string) {
+		return fmt.Errorf("unable to find router within: %#v", res.RouterSet)
+	}
+
+	var networkInterface *types.NetworkInterfaceSetOfNiftyDescribeRouters
+	for _, ni := range router.NetworkInterfaceSet {
+		if isTargetNetworkInterface(d, ni) {
+			ni := ni
+			networkInterface = &ni
+			break
+		}
+	}
+
+	if networkInterface == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if _, ok := d.GetOk("network_name"); ok {
+		if err := d.Set("network_name", networkInterface.NetworkName); err != nil {
+			return err
+		}
+	} else {
+		if err := d.Set("network_id", networkInterface.NetworkId); err != nil {
+			return err
+		}
+	}
+
+	// ip_address, dhcp_config_id and dhcp_options_id are set only when configured,
+	// since the API returns the values assigned to the common networks as well.
+	if d.Get("ip_address").(string) != "" {
+		if err := d.Set("ip_address", networkInterface.IpAddress); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("dhcp", networkInterface.Dhcp); err != nil {
+		return err
+	}
+
+	if d.Get("dhcp_config_id").(string) != "" {
+		if err := d.Set("dhcp_config_id", networkInterface.DhcpConfigId); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("dhcp_options_id").(string) != "" {
+		if err := d.Set("dhcp_options_id", networkInterface.DhcpOptionsId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
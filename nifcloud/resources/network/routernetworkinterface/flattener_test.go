@@ -0,0 +1,162 @@
+package routernetworkinterface
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id":       "test_router_id",
+		"network_id":      "test_network_id",
+		"ip_address":      "192.168.1.1",
+		"dhcp":            true,
+		"dhcp_config_id":  "test_dhcp_config_id",
+		"dhcp_options_id": "test_dhcp_options_id",
+	})
+	rd.SetId("test_router_id_test_network_id")
+
+	commonRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id":  "test_router_id",
+		"network_id": "net-COMMON_PRIVATE",
+	})
+	commonRd.SetId("test_router_id_net-COMMON_PRIVATE")
+
+	wantCommonRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id":  "test_router_id",
+		"network_id": "net-COMMON_PRIVATE",
+		"dhcp":       true,
+	})
+	wantCommonRd.SetId("test_router_id_net-COMMON_PRIVATE")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantDetachedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id":  "test_router_id",
+		"network_id": "test_network_id",
+	})
+	wantDetachedRd.SetId("test_router_id_test_network_id")
+
+	wantDetachedStateRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id":  "test_router_id",
+		"network_id": "test_network_id",
+	})
+
+	type args struct {
+		res *computing.NiftyDescribeRoutersOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId: nifcloud.String("test_router_id"),
+							NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribeRouters{
+								{
+									NetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+								},
+								{
+									NetworkId:     nifcloud.String("test_network_id"),
+									NetworkName:   nifcloud.String("test_network_name"),
+									IpAddress:     nifcloud.String("192.168.1.1"),
+									Dhcp:          nifcloud.Bool(true),
+									DhcpConfigId:  nifcloud.String("test_dhcp_config_id"),
+									DhcpOptionsId: nifcloud.String("test_dhcp_options_id"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "does not flatten the IP address assigned to the common network when not configured",
+			args: args{
+				d: commonRd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId: nifcloud.String("test_router_id"),
+							NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribeRouters{
+								{
+									NetworkId: nifcloud.String("net-COMMON_PRIVATE"),
+									IpAddress: nifcloud.String("10.100.0.1"),
+									Dhcp:      nifcloud.Bool(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: wantCommonRd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the network interface has been detached externally",
+			args: args{
+				d: wantDetachedRd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId: nifcloud.String("test_router_id"),
+							NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribeRouters{
+								{
+									NetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: wantDetachedStateRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package routernetworkinterface
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+const waiterInitialDelay = 3
+
+// updateRouterNetworkInterfaces rebuilds the router's network interfaces from the current ones
+// so that only the interface managed by this resource is added, changed or removed.
+func updateRouterNetworkInterfaces(ctx context.Context, d *schema.ResourceData, svc *computing.Client, remove bool) diag.Diagnostics {
+	routerID := d.Get("router_id").(string)
+	mutexkv.LockRouter(routerID)
+	defer mutexkv.UnlockRouter(routerID)
+
+	var key string
+	var err error
+	if v, ok := d.GetOk("network_id"); ok {
+		key, err = mutexkv.LockPrivateLan(ctx, v.(string), svc)
+	} else {
+		key, err = mutexkv.LockPrivateLanByName(ctx, d.Get("network_name").(string), svc)
+	}
+	if key != "" {
+		defer mutexkv.UnlockPrivateLan(key)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d := waitForRouterAvailable(ctx, d, svc); d != nil {
+		return d
+	}
+
+	res, err := svc.NiftyDescribeRouters(ctx, expandNiftyDescribeRoutersInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading router: %s", err))
+	}
+
+	input, err := expandNiftyUpdateRouterNetworkInterfacesInput(d, res, remove)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := svc.NiftyUpdateRouterNetworkInterfaces(ctx, input); err != nil {
+		return diag.FromErr(fmt.Errorf("failed updating router network_interface: %s", err))
+	}
+
+	return waitForRouterAvailable(ctx, d, svc)
+}
+
+func waitForRouterAvailable(ctx context.Context, d *schema.ResourceData, svc *computing.Client) diag.Diagnostics {
+	// lintignore:R018
+	time.Sleep(waiterInitialDelay * time.Second)
+	deadline, _ := ctx.Deadline()
+
+	if err := computing.NewRouterAvailableWaiter(svc).Wait(ctx, expandNiftyDescribeRoutersInput(d), time.Until(deadline)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for router available: %s", err))
+	}
+
+	return nil
+}
+
+func populateFromImport(d *schema.ResourceData) error {
+	// example: rtr-abcdefgh_net-COMMON_PRIVATE
+
+	parts := strings.SplitN(d.Id(), "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("unexpected format of import string (%q), expected ROUTERID_NETWORKID", d.Id())
+	}
+
+	if err := d.Set("router_id", parts[0]); err != nil {
+		return err
+	}
+
+	if err := d.Set("network_id", parts[1]); err != nil {
+		return err
+	}
+
+	return nil
+}
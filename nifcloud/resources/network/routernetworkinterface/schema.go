@@ -0,0 +1,85 @@
+package routernetworkinterface
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Provides a router network interface resource."
+
+// New returns the nifcloud_router_network_interface resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		CustomizeDiff: customizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := populateFromImport(d); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"router_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the router.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"network_id": {
+			Type:         schema.TypeString,
+			Description:  "The ID of the network to attach; 'net-COMMON_GLOBAL' or `net-COMMON_PRIVATE` or private lan network id.",
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"network_id", "network_name"},
+		},
+		"network_name": {
+			Type:         schema.TypeString,
+			Description:  "The private lan name of the network to attach.",
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"network_id", "network_name"},
+		},
+		"ip_address": {
+			Type:         schema.TypeString,
+			Description:  "The IP address of the network interface.",
+			Optional:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"dhcp": {
+			Type:        schema.TypeBool,
+			Description: "The flag to enable or disable DHCP.",
+			Optional:    true,
+			Default:     true,
+		},
+		"dhcp_config_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the DHCP config to attach.",
+			Optional:    true,
+		},
+		"dhcp_options_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the DHCP options to attach.",
+			Optional:    true,
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package routernetworkinterface
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d.HasChanges("ip_address", "dhcp", "dhcp_config_id", "dhcp_options_id") {
+		if d := updateRouterNetworkInterfaces(ctx, d, svc, false); d != nil {
+			return d
+		}
+	}
+
+	return read(ctx, d, meta)
+}
@@ -33,7 +33,7 @@ func newSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"route": {
 			Type:        schema.TypeSet,
-			Description: "A list of route objects.",
+			Description: "A list of route objects. Add this to `ignore_changes` when `nifcloud_route` is used for the same route table.",
 			Optional:    true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -0,0 +1,44 @@
+package routetableassociation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	target, targetID := getTarget(d)
+	mutexkv.LockRouter(targetID)
+	defer mutexkv.UnlockRouter(targetID)
+
+	if err := waitForTargetAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for %s available: %s", targetID, err))
+	}
+
+	var err error
+	switch target {
+	case targetRouter:
+		_, err = svc.AssociateRouteTable(ctx, expandAssociateRouteTableInput(d))
+	case targetVpnGateway:
+		_, err = svc.NiftyAssociateRouteTableWithVpnGateway(ctx, expandNiftyAssociateRouteTableWithVpnGatewayInput(d))
+	case targetELB:
+		_, err = svc.NiftyAssociateRouteTableWithElasticLoadBalancer(ctx, expandNiftyAssociateRouteTableWithElasticLoadBalancerInput(d))
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed associating route table: %s", err))
+	}
+
+	d.SetId(buildID(d))
+
+	if err := waitForTargetAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for %s available: %s", targetID, err))
+	}
+
+	return read(ctx, d, meta)
+}
@@ -0,0 +1,43 @@
+package routetableassociation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	target, targetID := getTarget(d)
+	mutexkv.LockRouter(targetID)
+	defer mutexkv.UnlockRouter(targetID)
+
+	if err := waitForTargetAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for %s available: %s", targetID, err))
+	}
+
+	var err error
+	switch target {
+	case targetRouter:
+		_, err = svc.DisassociateRouteTable(ctx, expandDisassociateRouteTableInput(d))
+	case targetVpnGateway:
+		_, err = svc.NiftyDisassociateRouteTableFromVpnGateway(ctx, expandNiftyDisassociateRouteTableFromVpnGatewayInput(d))
+	case targetELB:
+		_, err = svc.NiftyDisassociateRouteTableFromElasticLoadBalancer(ctx, expandNiftyDisassociateRouteTableFromElasticLoadBalancerInput(d))
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed disassociating route table: %s", err))
+	}
+
+	if err := waitForTargetAvailable(ctx, d, svc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for %s available: %s", targetID, err))
+	}
+
+	d.SetId("")
+	return nil
+}
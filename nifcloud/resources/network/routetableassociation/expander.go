@@ -0,0 +1,92 @@
+package routetableassociation
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func expandAssociateRouteTableInput(d *schema.ResourceData) *computing.AssociateRouteTableInput {
+	return &computing.AssociateRouteTableInput{
+		RouteTableId: nifcloud.String(d.Get("route_table_id").(string)),
+		RouterId:     nifcloud.String(d.Get("router_id").(string)),
+	}
+}
+
+func expandReplaceRouteTableAssociationInput(d *schema.ResourceData) *computing.ReplaceRouteTableAssociationInput {
+	return &computing.ReplaceRouteTableAssociationInput{
+		AssociationId: nifcloud.String(d.Get("route_table_association_id").(string)),
+		RouteTableId:  nifcloud.String(d.Get("route_table_id").(string)),
+	}
+}
+
+func expandDisassociateRouteTableInput(d *schema.ResourceData) *computing.DisassociateRouteTableInput {
+	return &computing.DisassociateRouteTableInput{
+		AssociationId: nifcloud.String(d.Get("route_table_association_id").(string)),
+	}
+}
+
+func expandNiftyDescribeRoutersInput(d *schema.ResourceData) *computing.NiftyDescribeRoutersInput {
+	return &computing.NiftyDescribeRoutersInput{
+		RouterId: []string{d.Get("router_id").(string)},
+	}
+}
+
+func expandNiftyAssociateRouteTableWithVpnGatewayInput(d *schema.ResourceData) *computing.NiftyAssociateRouteTableWithVpnGatewayInput {
+	return &computing.NiftyAssociateRouteTableWithVpnGatewayInput{
+		VpnGatewayId: nifcloud.String(d.Get("vpn_gateway_id").(string)),
+		RouteTableId: nifcloud.String(d.Get("route_table_id").(string)),
+	}
+}
+
+func expandNiftyReplaceRouteTableAssociationWithVpnGatewayInput(d *schema.ResourceData) *computing.NiftyReplaceRouteTableAssociationWithVpnGatewayInput {
+	return &computing.NiftyReplaceRouteTableAssociationWithVpnGatewayInput{
+		AssociationId: nifcloud.String(d.Get("route_table_association_id").(string)),
+		RouteTableId:  nifcloud.String(d.Get("route_table_id").(string)),
+	}
+}
+
+func expandNiftyDisassociateRouteTableFromVpnGatewayInput(d *schema.ResourceData) *computing.NiftyDisassociateRouteTableFromVpnGatewayInput {
+	return &computing.NiftyDisassociateRouteTableFromVpnGatewayInput{
+		AssociationId: nifcloud.String(d.Get("route_table_association_id").(string)),
+	}
+}
+
+func expandDescribeVpnGatewaysInput(d *schema.ResourceData) *computing.DescribeVpnGatewaysInput {
+	return &computing.DescribeVpnGatewaysInput{
+		VpnGatewayId: []string{d.Get("vpn_gateway_id").(string)},
+	}
+}
+
+func expandNiftyAssociateRouteTableWithElasticLoadBalancerInput(d *schema.ResourceData) *computing.NiftyAssociateRouteTableWithElasticLoadBalancerInput {
+	return &computing.NiftyAssociateRouteTableWithElasticLoadBalancerInput{
+		ElasticLoadBalancerId: nifcloud.String(d.Get("elb_id").(string)),
+		RouteTableId:          nifcloud.String(d.Get("route_table_id").(string)),
+	}
+}
+
+func expandNiftyReplaceRouteTableAssociationWithElasticLoadBalancerInput(
+	d *schema.ResourceData,
+) *computing.NiftyReplaceRouteTableAssociationWithElasticLoadBalancerInput {
+	return &computing.NiftyReplaceRouteTableAssociationWithElasticLoadBalancerInput{
+		AssociationId: nifcloud.String(d.Get("route_table_association_id").(string)),
+		RouteTableId:  nifcloud.String(d.Get("route_table_id").(string)),
+	}
+}
+
+func expandNiftyDisassociateRouteTableFromElasticLoadBalancerInput(
+	d *schema.ResourceData,
+) *computing.NiftyDisassociateRouteTableFromElasticLoadBalancerInput {
+	return &computing.NiftyDisassociateRouteTableFromElasticLoadBalancerInput{
+		AssociationId: nifcloud.String(d.Get("route_table_association_id").(string)),
+	}
+}
+
+func expandNiftyDescribeElasticLoadBalancersInput(d *schema.ResourceData) *computing.NiftyDescribeElasticLoadBalancersInput {
+	return &computing.NiftyDescribeElasticLoadBalancersInput{
+		ElasticLoadBalancers: &types.RequestElasticLoadBalancers{
+			ListOfRequestElasticLoadBalancerId: []string{d.Get("elb_id").(string)},
+		},
+	}
+}
@@ -0,0 +1,367 @@
+package routetableassociation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandAssociateRouteTableInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"router_id":                  "test_router_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("router_test_router_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.AssociateRouteTableInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.AssociateRouteTableInput{
+				RouteTableId: nifcloud.String("test_route_table_id"),
+				RouterId:     nifcloud.String("test_router_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandAssociateRouteTableInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandReplaceRouteTableAssociationInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"router_id":                  "test_router_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("router_test_router_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.ReplaceRouteTableAssociationInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.ReplaceRouteTableAssociationInput{
+				AssociationId: nifcloud.String("test_route_table_association_id"),
+				RouteTableId:  nifcloud.String("test_route_table_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandReplaceRouteTableAssociationInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDisassociateRouteTableInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"router_id":                  "test_router_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("router_test_router_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DisassociateRouteTableInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DisassociateRouteTableInput{
+				AssociationId: nifcloud.String("test_route_table_association_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDisassociateRouteTableInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeRoutersInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"router_id":                  "test_router_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("router_test_router_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeRoutersInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeRoutersInput{
+				RouterId: []string{"test_router_id"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeRoutersInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyAssociateRouteTableWithVpnGatewayInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"vpn_gateway_id":             "test_vpn_gateway_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("vpn_gateway_test_vpn_gateway_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyAssociateRouteTableWithVpnGatewayInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyAssociateRouteTableWithVpnGatewayInput{
+				VpnGatewayId: nifcloud.String("test_vpn_gateway_id"),
+				RouteTableId: nifcloud.String("test_route_table_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyAssociateRouteTableWithVpnGatewayInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyReplaceRouteTableAssociationWithVpnGatewayInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"vpn_gateway_id":             "test_vpn_gateway_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("vpn_gateway_test_vpn_gateway_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyReplaceRouteTableAssociationWithVpnGatewayInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyReplaceRouteTableAssociationWithVpnGatewayInput{
+				AssociationId: nifcloud.String("test_route_table_association_id"),
+				RouteTableId:  nifcloud.String("test_route_table_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyReplaceRouteTableAssociationWithVpnGatewayInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDisassociateRouteTableFromVpnGatewayInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"vpn_gateway_id":             "test_vpn_gateway_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("vpn_gateway_test_vpn_gateway_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDisassociateRouteTableFromVpnGatewayInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDisassociateRouteTableFromVpnGatewayInput{
+				AssociationId: nifcloud.String("test_route_table_association_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDisassociateRouteTableFromVpnGatewayInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeVpnGatewaysInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"vpn_gateway_id":             "test_vpn_gateway_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("vpn_gateway_test_vpn_gateway_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeVpnGatewaysInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.DescribeVpnGatewaysInput{
+				VpnGatewayId: []string{"test_vpn_gateway_id"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeVpnGatewaysInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyAssociateRouteTableWithElasticLoadBalancerInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"elb_id":                     "test_elb_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("elb_test_elb_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyAssociateRouteTableWithElasticLoadBalancerInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyAssociateRouteTableWithElasticLoadBalancerInput{
+				ElasticLoadBalancerId: nifcloud.String("test_elb_id"),
+				RouteTableId:          nifcloud.String("test_route_table_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyAssociateRouteTableWithElasticLoadBalancerInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyReplaceRouteTableAssociationWithElasticLoadBalancerInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"elb_id":                     "test_elb_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("elb_test_elb_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyReplaceRouteTableAssociationWithElasticLoadBalancerInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyReplaceRouteTableAssociationWithElasticLoadBalancerInput{
+				AssociationId: nifcloud.String("test_route_table_association_id"),
+				RouteTableId:  nifcloud.String("test_route_table_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyReplaceRouteTableAssociationWithElasticLoadBalancerInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDisassociateRouteTableFromElasticLoadBalancerInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"elb_id":                     "test_elb_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("elb_test_elb_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDisassociateRouteTableFromElasticLoadBalancerInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDisassociateRouteTableFromElasticLoadBalancerInput{
+				AssociationId: nifcloud.String("test_route_table_association_id"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDisassociateRouteTableFromElasticLoadBalancerInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandNiftyDescribeElasticLoadBalancersInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"elb_id":                     "test_elb_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("elb_test_elb_id")
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeElasticLoadBalancersInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.NiftyDescribeElasticLoadBalancersInput{
+				ElasticLoadBalancers: &types.RequestElasticLoadBalancers{
+					ListOfRequestElasticLoadBalancerId: []string{"test_elb_id"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeElasticLoadBalancersInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package routetableassociation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flattenRouter(d *schema.ResourceData, res *computing.NiftyDescribeRoutersOutput) error {
+	if res == nil || len(res.RouterSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	router := res.RouterSet[0]
+
+	if nifcloud.ToString(router.RouterId) != d.Get("router_id").(string) {
+		return fmt.Errorf("unable to find router within: %#v", res.RouterSet)
+	}
+
+	return flattenAssociation(d, router.RouteTableId, router.RouteTableAssociationId)
+}
+
+func flattenVpnGateway(d *schema.ResourceData, res *computing.DescribeVpnGatewaysOutput) error {
+	if res == nil || len(res.VpnGatewaySet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	vpnGateway := res.VpnGatewaySet[0]
+
+	if nifcloud.ToString(vpnGateway.VpnGatewayId) != d.Get("vpn_gateway_id").(string) {
+		return fmt.Errorf("unable to find vpngateway within: %#v", res.VpnGatewaySet)
+	}
+
+	return flattenAssociation(d, vpnGateway.RouteTableId, vpnGateway.RouteTableAssociationId)
+}
+
+func flattenELB(d *schema.ResourceData, res *computing.NiftyDescribeElasticLoadBalancersOutput) error {
+	if res == nil || len(res.NiftyDescribeElasticLoadBalancersResult.ElasticLoadBalancerDescriptions) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	elb := res.NiftyDescribeElasticLoadBalancersResult.ElasticLoadBalancerDescriptions[0]
+
+	if nifcloud.ToString(elb.ElasticLoadBalancerId) != d.Get("elb_id").(string) {
+		return fmt.Errorf(
+			"unable to find elb within: %#v",
+			res.NiftyDescribeElasticLoadBalancersResult.ElasticLoadBalancerDescriptions,
+		)
+	}
+
+	return flattenAssociation(d, elb.RouteTableId, elb.RouteTableAssociationId)
+}
+
+func flattenAssociation(d *schema.ResourceData, routeTableID, associationID *string) error {
+	if nifcloud.ToString(routeTableID) == "" {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("route_table_id", routeTableID); err != nil {
+		return err
+	}
+
+	if err := d.Set("route_table_association_id", associationID); err != nil {
+		return err
+	}
+
+	return nil
+}
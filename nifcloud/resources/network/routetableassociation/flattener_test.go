@@ -0,0 +1,311 @@
+package routetableassociation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenRouter(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"router_id":                  "test_router_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("router_test_router_id")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantDisassociatedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id": "test_router_id",
+	})
+	wantDisassociatedRd.SetId("router_test_router_id")
+
+	wantDisassociatedStateRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id": "test_router_id",
+	})
+
+	type args struct {
+		res *computing.NiftyDescribeRoutersOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId:                nifcloud.String("test_router_id"),
+							RouteTableId:            nifcloud.String("test_route_table_id"),
+							RouteTableAssociationId: nifcloud.String("test_route_table_association_id"),
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the route table has been disassociated externally",
+			args: args{
+				d: wantDisassociatedRd,
+				res: &computing.NiftyDescribeRoutersOutput{
+					RouterSet: []types.RouterSetOfNiftyDescribeRouters{
+						{
+							RouterId:                nifcloud.String("test_router_id"),
+							RouteTableId:            nifcloud.String(""),
+							RouteTableAssociationId: nifcloud.String(""),
+						},
+					},
+				},
+			},
+			want: wantDisassociatedStateRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flattenRouter(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
+
+func TestFlattenVpnGateway(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"vpn_gateway_id":             "test_vpn_gateway_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("vpn_gateway_test_vpn_gateway_id")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantDisassociatedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"vpn_gateway_id": "test_vpn_gateway_id",
+	})
+	wantDisassociatedRd.SetId("vpn_gateway_test_vpn_gateway_id")
+
+	wantDisassociatedStateRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"vpn_gateway_id": "test_vpn_gateway_id",
+	})
+
+	type args struct {
+		res *computing.DescribeVpnGatewaysOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.DescribeVpnGatewaysOutput{
+					VpnGatewaySet: []types.VpnGatewaySetOfDescribeVpnGateways{
+						{
+							VpnGatewayId:            nifcloud.String("test_vpn_gateway_id"),
+							RouteTableId:            nifcloud.String("test_route_table_id"),
+							RouteTableAssociationId: nifcloud.String("test_route_table_association_id"),
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.DescribeVpnGatewaysOutput{
+					VpnGatewaySet: []types.VpnGatewaySetOfDescribeVpnGateways{},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the route table has been disassociated externally",
+			args: args{
+				d: wantDisassociatedRd,
+				res: &computing.DescribeVpnGatewaysOutput{
+					VpnGatewaySet: []types.VpnGatewaySetOfDescribeVpnGateways{
+						{
+							VpnGatewayId:            nifcloud.String("test_vpn_gateway_id"),
+							RouteTableId:            nifcloud.String(""),
+							RouteTableAssociationId: nifcloud.String(""),
+						},
+					},
+				},
+			},
+			want: wantDisassociatedStateRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flattenVpnGateway(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
+
+func TestFlattenELB(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"route_table_id":             "test_route_table_id",
+		"elb_id":                     "test_elb_id",
+		"route_table_association_id": "test_route_table_association_id",
+	})
+	rd.SetId("elb_test_elb_id")
+
+	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	wantDisassociatedRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"elb_id": "test_elb_id",
+	})
+	wantDisassociatedRd.SetId("elb_test_elb_id")
+
+	wantDisassociatedStateRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"elb_id": "test_elb_id",
+	})
+
+	type args struct {
+		res *computing.NiftyDescribeElasticLoadBalancersOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name string
+		args args
+		want *schema.ResourceData
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &computing.NiftyDescribeElasticLoadBalancersOutput{
+					NiftyDescribeElasticLoadBalancersResult: &types.NiftyDescribeElasticLoadBalancersResult{
+						ElasticLoadBalancerDescriptions: []types.ElasticLoadBalancerDescriptions{
+							{
+								ElasticLoadBalancerId:   nifcloud.String("test_elb_id"),
+								RouteTableId:            nifcloud.String("test_route_table_id"),
+								RouteTableAssociationId: nifcloud.String("test_route_table_association_id"),
+							},
+						},
+					},
+				},
+			},
+			want: rd,
+		},
+		{
+			name: "flattens the response even when the resource has been removed externally",
+			args: args{
+				d: wantNotFoundRd,
+				res: &computing.NiftyDescribeElasticLoadBalancersOutput{
+					NiftyDescribeElasticLoadBalancersResult: &types.NiftyDescribeElasticLoadBalancersResult{
+						ElasticLoadBalancerDescriptions: []types.ElasticLoadBalancerDescriptions{},
+					},
+				},
+			},
+			want: wantNotFoundRd,
+		},
+		{
+			name: "flattens the response even when the route table has been disassociated externally",
+			args: args{
+				d: wantDisassociatedRd,
+				res: &computing.NiftyDescribeElasticLoadBalancersOutput{
+					NiftyDescribeElasticLoadBalancersResult: &types.NiftyDescribeElasticLoadBalancersResult{
+						ElasticLoadBalancerDescriptions: []types.ElasticLoadBalancerDescriptions{
+							{
+								ElasticLoadBalancerId:   nifcloud.String("test_elb_id"),
+								RouteTableId:            nifcloud.String(""),
+								RouteTableAssociationId: nifcloud.String(""),
+							},
+						},
+					},
+				},
+			},
+			want: wantDisassociatedStateRd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flattenELB(tt.args.d, tt.args.res)
+			assert.NoError(t, err)
+
+			if tt.args.res == nil {
+				return
+			}
+
+			wantState := tt.want.State()
+			if wantState == nil {
+				tt.want.SetId("some")
+				wantState = tt.want.State()
+			}
+
+			gotState := tt.args.d.State()
+			if gotState == nil {
+				tt.args.d.SetId("some")
+				gotState = tt.args.d.State()
+			}
+
+			assert.Equal(t, wantState.Attributes, gotState.Attributes)
+		})
+	}
+}
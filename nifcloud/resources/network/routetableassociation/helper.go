@@ -0,0 +1,64 @@
+package routetableassociation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+const (
+	targetRouter     = "router_id"
+	targetVpnGateway = "vpn_gateway_id"
+	targetELB        = "elb_id"
+)
+
+// getTarget returns the attribute name and the ID of the resource associated with the route table.
+func getTarget(d *schema.ResourceData) (string, string) {
+	for _, target := range []string{targetRouter, targetVpnGateway, targetELB} {
+		if v, ok := d.GetOk(target); ok {
+			return target, v.(string)
+		}
+	}
+	return "", ""
+}
+
+func buildID(d *schema.ResourceData) string {
+	target, targetID := getTarget(d)
+	return strings.TrimSuffix(target, "_id") + "_" + targetID
+}
+
+func parseID(id string) (string, string, error) {
+	// example: router_rtr-abcdefgh
+
+	errStr := "unexpected format of import string (%q), expected TARGETTYPE_TARGETID (TARGETTYPE is router, vpn_gateway or elb): %s"
+
+	i := strings.LastIndex(id, "_")
+	if i < 0 || i == len(id)-1 {
+		return "", "", fmt.Errorf(errStr, id, "invalid parts")
+	}
+
+	target := id[:i] + "_id"
+	if target != targetRouter && target != targetVpnGateway && target != targetELB {
+		return "", "", fmt.Errorf(errStr, id, "invalid target type")
+	}
+
+	return target, id[i+1:], nil
+}
+
+func waitForTargetAvailable(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	deadline, _ := ctx.Deadline()
+
+	target, _ := getTarget(d)
+	switch target {
+	case targetRouter:
+		return computing.NewRouterAvailableWaiter(svc).Wait(ctx, expandNiftyDescribeRoutersInput(d), time.Until(deadline))
+	case targetVpnGateway:
+		return computing.NewVpnGatewayAvailableWaiter(svc).Wait(ctx, expandDescribeVpnGatewaysInput(d), time.Until(deadline))
+	default:
+		return computing.NewElasticLoadBalancerAvailableWaiter(svc).Wait(ctx, expandNiftyDescribeElasticLoadBalancersInput(d), time.Until(deadline))
+	}
+}
@@ -0,0 +1,72 @@
+package routetableassociation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	var err error
+	target, _ := getTarget(d)
+	switch target {
+	case targetRouter:
+		err = readRouter(ctx, d, svc)
+	case targetVpnGateway:
+		err = readVpnGateway(ctx, d, svc)
+	case targetELB:
+		err = readELB(ctx, d, svc)
+	}
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && isNotFoundErrorCode(awsErr.ErrorCode()) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+	}
+
+	return nil
+}
+
+func readRouter(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	res, err := svc.NiftyDescribeRouters(ctx, expandNiftyDescribeRoutersInput(d))
+	if err != nil {
+		return err
+	}
+	return flattenRouter(d, res)
+}
+
+func readVpnGateway(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	res, err := svc.DescribeVpnGateways(ctx, expandDescribeVpnGatewaysInput(d))
+	if err != nil {
+		return err
+	}
+	return flattenVpnGateway(d, res)
+}
+
+func readELB(ctx context.Context, d *schema.ResourceData, svc *computing.Client) error {
+	res, err := svc.NiftyDescribeElasticLoadBalancers(ctx, expandNiftyDescribeElasticLoadBalancersInput(d))
+	if err != nil {
+		return err
+	}
+	return flattenELB(d, res)
+}
+
+func isNotFoundErrorCode(code string) bool {
+	switch code {
+	case "Client.InvalidParameterNotFound.RouterId",
+		"Client.InvalidParameterNotFound.VpnGatewayId",
+		"Client.InvalidParameterNotFound.ElasticLoadBalancerId":
+		return true
+	}
+	return false
+}
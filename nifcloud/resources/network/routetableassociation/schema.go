@@ -0,0 +1,75 @@
+package routetableassociation
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Provides a route table association resource."
+
+// New returns the nifcloud_route_table_association resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				target, targetID, err := parseID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				if err := d.Set(target, targetID); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"route_table_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the route table to associate.",
+			Required:    true,
+		},
+		"router_id": {
+			Type:         schema.TypeString,
+			Description:  "The ID of the router to associate with the route table.",
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{targetRouter, targetVpnGateway, targetELB},
+		},
+		"vpn_gateway_id": {
+			Type:         schema.TypeString,
+			Description:  "The ID of the vpn gateway to associate with the route table.",
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{targetRouter, targetVpnGateway, targetELB},
+		},
+		"elb_id": {
+			Type:         schema.TypeString,
+			Description:  "The ID of the multi load balancer to associate with the route table.",
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{targetRouter, targetVpnGateway, targetELB},
+		},
+		"route_table_association_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the route table association.",
+			Computed:    true,
+		},
+	}
+}
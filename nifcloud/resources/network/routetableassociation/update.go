@@ -0,0 +1,44 @@
+package routetableassociation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/mutexkv"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d.HasChange("route_table_id") {
+		target, targetID := getTarget(d)
+		mutexkv.LockRouter(targetID)
+		defer mutexkv.UnlockRouter(targetID)
+
+		if err := waitForTargetAvailable(ctx, d, svc); err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for %s available: %s", targetID, err))
+		}
+
+		var err error
+		switch target {
+		case targetRouter:
+			_, err = svc.ReplaceRouteTableAssociation(ctx, expandReplaceRouteTableAssociationInput(d))
+		case targetVpnGateway:
+			_, err = svc.NiftyReplaceRouteTableAssociationWithVpnGateway(ctx, expandNiftyReplaceRouteTableAssociationWithVpnGatewayInput(d))
+		case targetELB:
+			_, err = svc.NiftyReplaceRouteTableAssociationWithElasticLoadBalancer(ctx, expandNiftyReplaceRouteTableAssociationWithElasticLoadBalancerInput(d))
+		}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating route table association: %s", err))
+		}
+
+		if err := waitForTargetAvailable(ctx, d, svc); err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for %s available: %s", targetID, err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
@@ -3,6 +3,7 @@ package vpnconnection
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,5 +23,12 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 
 	d.SetId(nifcloud.ToString(res.VpnConnection.VpnConnectionId))
 
+	if d.Get("wait_until_up").(bool) {
+		deadline, _ := ctx.Deadline()
+		if err := waitUntilTunnelsUp(ctx, d, svc, time.Until(deadline)); err != nil {
+			return diag.FromErr(fmt.Errorf("failed waiting for vpn connection tunnels to become up: %s", err))
+		}
+	}
+
 	return read(ctx, d, meta)
 }
@@ -2,6 +2,7 @@ package vpnconnection
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
@@ -122,5 +123,29 @@ func flatten(d *schema.ResourceData, res *computing.DescribeVpnConnectionsOutput
 		return err
 	}
 
+	if err := d.Set("state", vpnConnection.State); err != nil {
+		return err
+	}
+
+	var vgwTelemetry []map[string]interface{}
+	for _, t := range vpnConnection.VgwTelemetry {
+		var lastStatusChange string
+		if t.LastStatusChange != nil {
+			lastStatusChange = t.LastStatusChange.Format(time.RFC3339)
+		}
+
+		vgwTelemetry = append(vgwTelemetry, map[string]interface{}{
+			"outside_ip_address":   nifcloud.ToString(t.OutsideIpAddress),
+			"status":               nifcloud.ToString(t.Status),
+			"status_message":       nifcloud.ToString(t.StatusMessage),
+			"last_status_change":   lastStatusChange,
+			"accepted_route_count": nifcloud.ToInt32(t.AcceptedRouteCount),
+		})
+	}
+
+	if err := d.Set("vgw_telemetry", vgwTelemetry); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package vpnconnection
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
@@ -35,6 +36,14 @@ func TestFlatten(t *testing.T) {
 		"ipsec_config_diffie_hellman_group":                    5,
 		"mtu":                                                  "1000",
 		"description":                                          "test_description",
+		"state":                                                "available",
+		"vgw_telemetry": []interface{}{map[string]interface{}{
+			"outside_ip_address":   "192.0.2.1",
+			"status":               "UP",
+			"status_message":       "test_status_message",
+			"last_status_change":   "2022-01-02T03:04:05Z",
+			"accepted_route_count": 1,
+		}},
 	})
 
 	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
@@ -82,6 +91,16 @@ func TestFlatten(t *testing.T) {
 								Mtu:                                  nifcloud.String("1000"),
 							},
 							NiftyVpnConnectionDescription: nifcloud.String("test_description"),
+							State:                         nifcloud.String("available"),
+							VgwTelemetry: []types.VgwTelemetry{
+								{
+									OutsideIpAddress:   nifcloud.String("192.0.2.1"),
+									Status:             nifcloud.String("UP"),
+									StatusMessage:      nifcloud.String("test_status_message"),
+									LastStatusChange:   nifcloud.Time(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)),
+									AcceptedRouteCount: nifcloud.Int32(1),
+								},
+							},
 						},
 					},
 				},
@@ -0,0 +1,45 @@
+package vpnconnection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+const tunnelStatusUp = "UP"
+
+func waitUntilTunnelsUp(ctx context.Context, d *schema.ResourceData, svc *computing.Client, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		res, err := svc.DescribeVpnConnections(ctx, expandDescribeVpnConnectionsInput(d))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if len(res.VpnConnectionSet) == 0 {
+			return resource.RetryableError(fmt.Errorf("expected vpn connection %s to be found", d.Id()))
+		}
+
+		telemetry := res.VpnConnectionSet[0].VgwTelemetry
+		if len(telemetry) == 0 {
+			return resource.RetryableError(fmt.Errorf("expected vpn connection %s to have tunnels", d.Id()))
+		}
+
+		for _, t := range telemetry {
+			status := nifcloud.ToString(t.Status)
+			if !strings.EqualFold(status, tunnelStatusUp) {
+				return resource.RetryableError(fmt.Errorf(
+					"expected tunnel %s of vpn connection %s to be up but was in status %s: %s",
+					nifcloud.ToString(t.OutsideIpAddress), d.Id(), status, nifcloud.ToString(t.StatusMessage),
+				))
+			}
+		}
+
+		return nil
+	})
+}
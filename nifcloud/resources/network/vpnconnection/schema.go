@@ -18,6 +18,7 @@ func New() *schema.Resource {
 
 		CreateContext: create,
 		ReadContext:   read,
+		UpdateContext: schema.NoopContext,
 		DeleteContext: delete,
 
 		Importer: &schema.ResourceImporter{
@@ -198,5 +199,50 @@ func newSchema() map[string]*schema.Schema {
 			Description: "The id of the vpn connection.",
 			Computed:    true,
 		},
+		"wait_until_up": {
+			Type:        schema.TypeBool,
+			Description: "If true, the creation waits until all tunnels of the vpn connection become up. Changing this does not affect the existing vpn connection.",
+			Optional:    true,
+			Default:     false,
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Description: "The state of the vpn connection.",
+			Computed:    true,
+		},
+		"vgw_telemetry": {
+			Type:        schema.TypeList,
+			Description: "The tunnel status of the vpn connection.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"outside_ip_address": {
+						Type:        schema.TypeString,
+						Description: "The global IP address of the vpn gateway for the tunnel.",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "The status of the tunnel.",
+						Computed:    true,
+					},
+					"status_message": {
+						Type:        schema.TypeString,
+						Description: "The status message of the tunnel including the IKE and IPsec SA status.",
+						Computed:    true,
+					},
+					"last_status_change": {
+						Type:        schema.TypeString,
+						Description: "The date of the last status change of the tunnel (RFC3339 format).",
+						Computed:    true,
+					},
+					"accepted_route_count": {
+						Type:        schema.TypeInt,
+						Description: "The number of accepted routes.",
+						Computed:    true,
+					},
+				},
+			},
+		},
 	}
 }
@@ -37,6 +37,7 @@ func expandCreateVpnGatewayInput(d *schema.ResourceData) *computing.CreateVpnGat
 		NiftyVpnGatewayDescription: nifcloud.String(d.Get("description").(string)),
 		NiftyVpnGatewayName:        nifcloud.String(d.Get("name").(string)),
 		NiftyVpnGatewayType:        types.NiftyVpnGatewayTypeOfCreateVpnGatewayRequest(d.Get("type").(string)),
+		NiftyRedundancy:            nifcloud.Bool(d.Get("redundancy").(bool)),
 		Placement:                  placement,
 		NiftyNetwork:               niftyNetwork,
 		SecurityGroup:              securityGroup,
@@ -21,6 +21,7 @@ func TestExpandCreateVpnGatewayInput_netid_and_netname(t *testing.T) {
 		"network_name":      "test_network_name",
 		"ip_address":        "test_ip_address",
 		"security_group":    "test_security_group",
+		"redundancy":        true,
 	})
 	rd.SetId("test_vpngateway_id")
 
@@ -37,6 +38,7 @@ func TestExpandCreateVpnGatewayInput_netid_and_netname(t *testing.T) {
 				NiftyVpnGatewayDescription: nifcloud.String("test_description"),
 				NiftyVpnGatewayName:        nifcloud.String("test_name"),
 				NiftyVpnGatewayType:        types.NiftyVpnGatewayTypeOfCreateVpnGatewayRequest("test_type"),
+				NiftyRedundancy:            nifcloud.Bool(true),
 				Placement: &types.RequestPlacementOfCreateVpnGateway{
 					AvailabilityZone: nifcloud.String("test_availability_zone"),
 				},
@@ -84,6 +86,7 @@ func TestExpandCreateVpnGatewayInput_netid_only(t *testing.T) {
 				NiftyVpnGatewayDescription: nifcloud.String("test_description"),
 				NiftyVpnGatewayName:        nifcloud.String("test_name"),
 				NiftyVpnGatewayType:        types.NiftyVpnGatewayTypeOfCreateVpnGatewayRequest("test_type"),
+				NiftyRedundancy:            nifcloud.Bool(false),
 				Placement: &types.RequestPlacementOfCreateVpnGateway{
 					AvailabilityZone: nifcloud.String("test_availability_zone"),
 				},
@@ -131,6 +134,7 @@ func TestExpandCreateVpnGatewayInput_netname_only(t *testing.T) {
 				NiftyVpnGatewayDescription: nifcloud.String("test_description"),
 				NiftyVpnGatewayName:        nifcloud.String("test_name"),
 				NiftyVpnGatewayType:        types.NiftyVpnGatewayTypeOfCreateVpnGatewayRequest("test_type"),
+				NiftyRedundancy:            nifcloud.Bool(false),
 				Placement: &types.RequestPlacementOfCreateVpnGateway{
 					AvailabilityZone: nifcloud.String("test_availability_zone"),
 				},
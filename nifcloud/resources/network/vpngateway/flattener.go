@@ -44,6 +44,10 @@ func flatten(d *schema.ResourceData, res *computing.DescribeVpnGatewaysOutput) e
 		return err
 	}
 
+	if err := d.Set("redundancy", nifcloud.ToBool(vpnGateway.NiftyRedundancy)); err != nil {
+		return err
+	}
+
 	for _, n := range vpnGateway.NetworkInterfaceSet {
 		switch nifcloud.ToString(n.NetworkId) {
 		case "net-COMMON_GLOBAL":
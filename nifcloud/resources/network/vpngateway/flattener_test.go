@@ -26,6 +26,7 @@ func TestFlatten(t *testing.T) {
 		"security_group":             "test_security_group",
 		"route_table_id":             "test_route_table_id",
 		"route_table_association_id": "test_route_table_association_id",
+		"redundancy":                 true,
 	})
 	rd.SetId("test_vpngateway_id")
 
@@ -53,6 +54,7 @@ func TestFlatten(t *testing.T) {
 							AvailabilityZone:           nifcloud.String("test_availability_zone"),
 							NextMonthAccountingType:    nifcloud.String("test_accounting_type"),
 							NiftyVpnGatewayDescription: nifcloud.String("test_description"),
+							NiftyRedundancy:            nifcloud.Bool(true),
 							NetworkInterfaceSet: []types.NetworkInterfaceSetOfDescribeVpnGateways{
 								{
 									NetworkId:   nifcloud.String("test_network_id"),
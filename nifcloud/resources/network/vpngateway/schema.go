@@ -76,6 +76,13 @@ func newSchema() map[string]*schema.Schema {
 			Default:      "2",
 			ValidateFunc: validation.StringInSlice([]string{"1", "2"}, false),
 		},
+		"redundancy": {
+			Type:        schema.TypeBool,
+			Description: "Whether to create the vpn gateway as a redundant pair.",
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
 		"network_id": {
 			Type:        schema.TypeString,
 			Description: "The id for the network.",
@@ -119,7 +126,7 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"route_table_id": {
 			Type:        schema.TypeString,
-			Description: "The ID of the route table to attach.",
+			Description: "The ID of the route table to attach. Add this to `ignore_changes` when `nifcloud_route_table_association` is used for the VPN gateway.",
 			Optional:    true,
 		},
 	}
@@ -7,7 +7,8 @@ import (
 	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
 )
 
-func flatten(d *schema.ResourceData, bucket types.Buckets,
+// Flatten sets the attributes of the bucket to the resource data.
+func Flatten(d *schema.ResourceData, bucket types.Buckets,
 	versioningRes *storage.GetBucketVersioningOutput, policyRes *storage.GetBucketPolicyOutput) error {
 	if err := d.Set("bucket", bucket.Name); err != nil {
 		return err
@@ -73,7 +73,7 @@ func TestFlatten(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := flatten(tt.args.d, tt.args.bucket, tt.args.versioningRes, tt.args.policyRes)
+			err := Flatten(tt.args.d, tt.args.bucket, tt.args.versioningRes, tt.args.policyRes)
 			assert.NoError(t, err)
 
 			wantState := tt.want.State()
@@ -20,7 +20,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Di
 		return diag.FromErr(fmt.Errorf("failed reading buckets: %s", err))
 	}
 
-	bucket, found := findBucket(res.Buckets, d.Id())
+	bucket, found := FindBucket(res.Buckets, d.Id())
 	if !found {
 		d.SetId("")
 		return nil
@@ -43,14 +43,15 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Di
 		}
 	}
 
-	if err := flatten(d, bucket, versioningRes, policyRes); err != nil {
+	if err := Flatten(d, bucket, versioningRes, policyRes); err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func findBucket(buckets []types.Buckets, name string) (types.Buckets, bool) {
+// FindBucket returns the bucket with the given name from the GetService result.
+func FindBucket(buckets []types.Buckets, name string) (types.Buckets, bool) {
 	for _, bucket := range buckets {
 		if nifcloud.ToString(bucket.Name) == name {
 			return bucket, true